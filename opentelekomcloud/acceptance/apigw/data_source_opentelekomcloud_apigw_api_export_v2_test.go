@@ -0,0 +1,53 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceApiExport_basic(t *testing.T) {
+	name := fmt.Sprintf("apigw_acc_export%s", acctest.RandString(5))
+	dataSourceName := "data.opentelekomcloud_apigw_api_export_v2.export"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApiExport_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "content"),
+					resource.TestCheckResourceAttr(dataSourceName, "format", "json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApiExport_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_apigw_api_publishment_v2" "pub" {
+  gateway_id     = opentelekomcloud_apigw_gateway_v2.gateway.id
+  environment_id = opentelekomcloud_apigw_environment_v2.env.id
+  api_id         = opentelekomcloud_apigw_api_import_v2.import.api_ids["GET /pets"]
+}
+
+data "opentelekomcloud_apigw_api_export_v2" "export" {
+  gateway_id     = opentelekomcloud_apigw_gateway_v2.gateway.id
+  group_id       = opentelekomcloud_apigw_group_v2.group.id
+  environment_id = opentelekomcloud_apigw_environment_v2.env.id
+  define         = "full"
+
+  depends_on = [opentelekomcloud_apigw_api_publishment_v2.pub]
+}
+`, testAccApiImport_basic(testAccApigwApi_base(name)))
+}
@@ -0,0 +1,47 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceApigwApis_basic(t *testing.T) {
+	name := fmt.Sprintf("apigw_acc_api%s", acctest.RandString(5))
+	dataSourceName := "data.opentelekomcloud_apigw_apis_v2.apis"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApigwApis_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "apis.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "apis.0.type", "Public"),
+					resource.TestCheckResourceAttr(dataSourceName, "apis.0.request_method", "GET"),
+					resource.TestCheckResourceAttr(dataSourceName, "apis.0.request_uri", "/user_info/{user_age}"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "apis.0.id", "opentelekomcloud_apigw_api_v2.api", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApigwApis_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_apigw_apis_v2" "apis" {
+  gateway_id = opentelekomcloud_apigw_gateway_v2.gateway.id
+  group_id   = opentelekomcloud_apigw_group_v2.group.id
+  name       = opentelekomcloud_apigw_api_v2.api.name
+}
+`, testAccApigwApi_basic(testAccApigwApi_base(name), name))
+}
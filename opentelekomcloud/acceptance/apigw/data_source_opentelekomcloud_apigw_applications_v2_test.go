@@ -0,0 +1,45 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceApigwApplications_basic(t *testing.T) {
+	name := fmt.Sprintf("apigw_acc_app%s", acctest.RandString(5))
+	dataSourceName := "data.opentelekomcloud_apigw_applications_v2.applications"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApigwApplications_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "applications.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "applications.0.description", "test description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "applications.0.id", "opentelekomcloud_apigw_application_v2.app", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "applications.0.app_key", "opentelekomcloud_apigw_application_v2.app", "app_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApigwApplications_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_apigw_applications_v2" "applications" {
+  gateway_id = opentelekomcloud_apigw_gateway_v2.gateway.id
+  name       = opentelekomcloud_apigw_application_v2.app.name
+}
+`, testAccApplication_basic(name, "test description"))
+}
@@ -0,0 +1,46 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceApigwCustomAuthorizers_basic(t *testing.T) {
+	name := fmt.Sprintf("apigw_acc_authorizer%s", acctest.RandString(5))
+	dataSourceName := "data.opentelekomcloud_apigw_custom_authorizers_v2.authorizers"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApigwCustomAuthorizers_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "authorizers.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "authorizers.0.type", "FRONTEND"),
+					resource.TestCheckResourceAttr(dataSourceName, "authorizers.0.ttl", "60"),
+					resource.TestCheckResourceAttr(dataSourceName, "authorizers.0.identity.0.name", "user_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "authorizers.0.id", "opentelekomcloud_apigw_custom_authorizer_v2.authorizer", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApigwCustomAuthorizers_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_apigw_custom_authorizers_v2" "authorizers" {
+  gateway_id = opentelekomcloud_apigw_gateway_v2.gateway.id
+  name       = opentelekomcloud_apigw_custom_authorizer_v2.authorizer.name
+}
+`, testAccCustomAuthorizer_front(name))
+}
@@ -0,0 +1,44 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceApigwEnvironments_basic(t *testing.T) {
+	name := fmt.Sprintf("apigw_acc_env%s", acctest.RandString(5))
+	dataSourceName := "data.opentelekomcloud_apigw_environments_v2.environments"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApigwEnvironments_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "environments.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "environments.0.description", "test description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "environments.0.id", "opentelekomcloud_apigw_environment_v2.env", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApigwEnvironments_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_apigw_environments_v2" "environments" {
+  gateway_id = opentelekomcloud_apigw_gateway_v2.gateway.id
+  name       = opentelekomcloud_apigw_environment_v2.env.name
+}
+`, testAccApigwApi_base(name))
+}
@@ -0,0 +1,44 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceApigwGateways_basic(t *testing.T) {
+	name := fmt.Sprintf("apigw_acc_gateway%s", acctest.RandString(5))
+	dataSourceName := "data.opentelekomcloud_apigw_gateways_v2.gateways"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApigwGateways_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "gateways.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "gateways.0.spec_id", "BASIC"),
+					resource.TestCheckResourceAttr(dataSourceName, "gateways.0.maintain_begin", "02:00:00"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "gateways.0.id", "opentelekomcloud_apigw_gateway_v2.gateway", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApigwGateways_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_apigw_gateways_v2" "gateways" {
+  name = opentelekomcloud_apigw_gateway_v2.gateway.name
+}
+`, testAccApigwApi_base(name))
+}
@@ -0,0 +1,44 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceApigwGroups_basic(t *testing.T) {
+	name := fmt.Sprintf("apigw_acc_group%s", acctest.RandString(5))
+	dataSourceName := "data.opentelekomcloud_apigw_groups_v2.groups"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApigwGroups_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.description", "test description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "groups.0.id", "opentelekomcloud_apigw_group_v2.group", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApigwGroups_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_apigw_groups_v2" "groups" {
+  gateway_id = opentelekomcloud_apigw_gateway_v2.gateway.id
+  name       = opentelekomcloud_apigw_group_v2.group.name
+}
+`, testAccApigwApi_base(name))
+}
@@ -0,0 +1,46 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceApigwThrottlingPolicies_basic(t *testing.T) {
+	name := acctest.RandString(10)
+	dataSourceName := "data.opentelekomcloud_apigw_throttling_policies_v2.policies"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApigwThrottlingPolicies_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "policies.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.type", "API-based"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.period", "15000"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.max_api_requests", "100"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policies.0.id", "opentelekomcloud_apigw_throttling_policy_v2.policy", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApigwThrottlingPolicies_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_apigw_throttling_policies_v2" "policies" {
+  gateway_id = opentelekomcloud_apigw_gateway_v2.gateway.id
+  name       = opentelekomcloud_apigw_throttling_policy_v2.policy.name
+}
+`, testAccAPIGWv2PolicyBasic(name))
+}
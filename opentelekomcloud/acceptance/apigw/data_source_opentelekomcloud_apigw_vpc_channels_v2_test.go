@@ -0,0 +1,45 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceApigwVpcChannels_basic(t *testing.T) {
+	name := fmt.Sprintf("apigw_acc_channel%s", acctest.RandString(5))
+	dataSourceName := "data.opentelekomcloud_apigw_vpc_channels_v2.vpc_channels"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApigwVpcChannels_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_channels.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_channels.0.port", "80"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_channels.0.member_type", "ecs"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_channels.0.id", "opentelekomcloud_apigw_vpc_channel_v2.channel", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApigwVpcChannels_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_apigw_vpc_channels_v2" "vpc_channels" {
+  gateway_id = opentelekomcloud_apigw_gateway_v2.gateway.id
+  name       = opentelekomcloud_apigw_vpc_channel_v2.channel.name
+}
+`, testAccChannel_basic(name))
+}
@@ -0,0 +1,155 @@
+package acceptance
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	apis "github.com/opentelekomcloud/gophertelekomcloud/openstack/apigw/v2/api"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceApigwApiImportName = "opentelekomcloud_apigw_api_import_v2.import"
+
+func TestAccApiImport_basic(t *testing.T) {
+	name := fmt.Sprintf("apigw_acc_import%s", acctest.RandString(5))
+	basicConfig := testAccApigwApi_base(name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckApiImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiImport_basic(basicConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceApigwApiImportName, "api_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceApigwApiImportName, "api_ids.GET /pets"),
+					resource.TestCheckResourceAttrSet(resourceApigwApiImportName, "api_ids.POST /pets"),
+				),
+			},
+			{
+				Config: testAccApiImport_update(basicConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceApigwApiImportName, "api_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceApigwApiImportName, "api_ids.GET /pets"),
+					resource.TestCheckResourceAttrSet(resourceApigwApiImportName, "api_ids.GET /pets/{id}"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApiImportDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.APIGWV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud APIG v2 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_apigw_api_import_v2" {
+			continue
+		}
+
+		for key, id := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "api_ids.") || key == "api_ids.%" {
+				continue
+			}
+			if _, err := apis.Get(client, rs.Primary.Attributes["gateway_id"], id); err == nil {
+				return fmt.Errorf("imported APIGW API %s still exists", id)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccApiImport_basic(relatedConfig string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_apigw_api_import_v2" "import" {
+  gateway_id = opentelekomcloud_apigw_gateway_v2.gateway.id
+  group_id   = opentelekomcloud_apigw_group_v2.group.id
+  mock_mode  = true
+
+  content = jsonencode({
+    swagger = "2.0"
+    info = {
+      title   = "pets"
+      version = "1.0"
+    }
+    paths = {
+      "/pets" = {
+        get = {
+          operationId = "listPets"
+          responses = {
+            "200" = { description = "OK" }
+          }
+        }
+        post = {
+          operationId = "createPet"
+          responses = {
+            "200" = { description = "OK" }
+          }
+        }
+      }
+    }
+  })
+}
+`, relatedConfig)
+}
+
+func testAccApiImport_update(relatedConfig string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_apigw_api_import_v2" "import" {
+  gateway_id = opentelekomcloud_apigw_gateway_v2.gateway.id
+  group_id   = opentelekomcloud_apigw_group_v2.group.id
+  mock_mode  = true
+
+  content = jsonencode({
+    swagger = "2.0"
+    info = {
+      title   = "pets"
+      version = "1.1"
+    }
+    paths = {
+      "/pets" = {
+        get = {
+          operationId = "listPets"
+          responses = {
+            "200" = { description = "OK" }
+          }
+        }
+      }
+      "/pets/{id}" = {
+        get = {
+          operationId = "getPet"
+          parameters = [
+            {
+              name     = "id"
+              in       = "path"
+              required = true
+              type     = "string"
+            },
+          ]
+          responses = {
+            "200" = { description = "OK" }
+          }
+        }
+      }
+    }
+  })
+}
+`, relatedConfig)
+}
@@ -0,0 +1,104 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cbr/v3/backups"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceCheckpointName = "opentelekomcloud_cbr_checkpoint_v3.checkpoint"
+
+func TestAccCBRCheckpointV3_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			qts := quotas.MultipleQuotas{
+				{Q: quotas.Volume, Count: 1},
+				{Q: quotas.VolumeSize, Count: 10},
+			}
+			quotas.BookMany(t, qts)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCBRCheckpointV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCBRCheckpointV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceCheckpointName, "name", "cbr-checkpoint-test"),
+					resource.TestCheckResourceAttr(resourceCheckpointName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceCheckpointName, "backups.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceCheckpointName, "backups.0.resource_id",
+						"opentelekomcloud_blockstorage_volume_v2.volume", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceCheckpointName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resources"},
+			},
+		},
+	})
+}
+
+func testAccCheckCBRCheckpointV3Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.CbrV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating CBRv3 client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_cbr_checkpoint_v3" {
+			continue
+		}
+
+		found, err := backups.List(client, backups.ListOpts{CheckpointID: rs.Primary.ID})
+		if err != nil {
+			return fmt.Errorf("error listing backups of CBR checkpoint: %w", err)
+		}
+		if len(found) != 0 {
+			return fmt.Errorf("CBR checkpoint still has %d backups", len(found))
+		}
+	}
+	return nil
+}
+
+const testAccCBRCheckpointV3Basic = `
+resource "opentelekomcloud_blockstorage_volume_v2" "volume" {
+  name = "cbr-checkpoint-test-volume"
+  size = 10
+
+  volume_type = "SSD"
+}
+
+resource "opentelekomcloud_cbr_vault_v3" "vault" {
+  name = "cbr-vault-checkpoint-test"
+
+  billing {
+    size          = 100
+    object_type   = "disk"
+    protect_type  = "backup"
+    charging_mode = "post_paid"
+  }
+
+  resource {
+    id   = opentelekomcloud_blockstorage_volume_v2.volume.id
+    type = "OS::Cinder::Volume"
+  }
+}
+
+resource "opentelekomcloud_cbr_checkpoint_v3" "checkpoint" {
+  vault_id    = opentelekomcloud_cbr_vault_v3.vault.id
+  name        = "cbr-checkpoint-test"
+  description = "CBR checkpoint for terraform provider test"
+}
+`
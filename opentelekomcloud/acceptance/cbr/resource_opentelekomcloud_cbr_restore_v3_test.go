@@ -0,0 +1,44 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceRestoreName = "opentelekomcloud_cbr_restore_v3.restore"
+
+func TestAccCBRRestoreV3_volume(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			qts := quotas.MultipleQuotas{
+				{Q: quotas.Volume, Count: 1},
+				{Q: quotas.VolumeSize, Count: 10},
+			}
+			quotas.BookMany(t, qts)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCBRCheckpointV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCBRRestoreV3Volume,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceRestoreName, "backup_id",
+						resourceCheckpointName, "backups.0.id"),
+					resource.TestCheckResourceAttr(resourceRestoreName, "resource_type", "OS::Cinder::Volume"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCBRRestoreV3Volume = testAccCBRCheckpointV3Basic + `
+resource "opentelekomcloud_cbr_restore_v3" "restore" {
+  backup_id = opentelekomcloud_cbr_checkpoint_v3.checkpoint.backups.0.id
+  volume_id = opentelekomcloud_blockstorage_volume_v2.volume.id
+}
+`
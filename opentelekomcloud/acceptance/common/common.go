@@ -119,6 +119,12 @@ func TestAccPreCheckDcHostedConnection(t *testing.T) {
 	}
 }
 
+func TestAccPreCheckErDcAttachment(t *testing.T) {
+	if env.OS_ER_INSTANCE_ID == "" || env.OS_ER_DC_VGW_ID == "" {
+		t.Skip("OS_ER_INSTANCE_ID and OS_ER_DC_VGW_ID must be set for this acceptance test")
+	}
+}
+
 func TestAccPreCheckAdminOnly(t *testing.T) {
 	v := os.Getenv("OS_TENANT_ADMIN")
 	if v == "" {
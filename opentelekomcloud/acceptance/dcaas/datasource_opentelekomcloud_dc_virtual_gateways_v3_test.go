@@ -0,0 +1,49 @@
+package dcaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccVirtualGatewaysV3Datasource_basic(t *testing.T) {
+	var (
+		name           = fmt.Sprintf("dc_acc_gw%s", acctest.RandString(5))
+		dataSourceName = "data.opentelekomcloud_dc_virtual_gateways_v3.filter"
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualGatewaysV3Datasource_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "virtual_gateways.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "virtual_gateways.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "virtual_gateways.0.description", "Created by acc test"),
+					resource.TestCheckResourceAttr(dataSourceName, "virtual_gateways.0.local_ep_group.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "virtual_gateways.0.id",
+						"opentelekomcloud_dc_virtual_gateway_v3.gw", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "virtual_gateways.0.vpc_id",
+						"opentelekomcloud_dc_virtual_gateway_v3.gw", "vpc_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "virtual_gateways.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVirtualGatewaysV3Datasource_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_dc_virtual_gateways_v3" "filter" {
+  virtual_gateway_id = opentelekomcloud_dc_virtual_gateway_v3.gw.id
+  name               = opentelekomcloud_dc_virtual_gateway_v3.gw.name
+}
+`, testAccVirtualInterface_base(name))
+}
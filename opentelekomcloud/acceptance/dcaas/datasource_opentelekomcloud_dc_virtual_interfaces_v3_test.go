@@ -0,0 +1,55 @@
+package dcaas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccVirtualInterfacesV3Datasource_basic(t *testing.T) {
+	dcId := os.Getenv("OS_DIRECT_CONNECT_ID")
+	if dcId == "" {
+		t.Skip("OS_DIRECT_CONNECT_ID should be set for acceptance tests")
+	}
+	var (
+		name           = fmt.Sprintf("dc_acc_vi%s", acctest.RandString(5))
+		vlan           = acctest.RandIntRange(1, 3999)
+		dataSourceName = "data.opentelekomcloud_dc_virtual_interfaces_v3.filter"
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualInterfacesV3Datasource_basic(name, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "virtual_interfaces.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "virtual_interfaces.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "virtual_interfaces.0.direct_connect_id", dcId),
+					resource.TestCheckResourceAttr(dataSourceName, "virtual_interfaces.0.vlan", fmt.Sprint(vlan)),
+					resource.TestCheckResourceAttr(dataSourceName, "virtual_interfaces.0.bandwidth", "5"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "virtual_interfaces.0.vgw_id",
+						"opentelekomcloud_dc_virtual_gateway_v3.gw", "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "virtual_interfaces.0.status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "virtual_interfaces.0.vif_peers.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVirtualInterfacesV3Datasource_basic(name string, vlan int) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_dc_virtual_interfaces_v3" "filter" {
+  virtual_interface_id = opentelekomcloud_dc_virtual_interface_v3.vi.id
+  vgw_id               = opentelekomcloud_dc_virtual_gateway_v3.gw.id
+}
+`, testAccVirtualInterface_basic(name, vlan))
+}
@@ -0,0 +1,49 @@
+package dcaas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestDirectConnectsV2Datasource_basic(t *testing.T) {
+	var directConnectName = fmt.Sprintf("dc-%s", acctest.RandString(5))
+	const dataSourceName = "data.opentelekomcloud_direct_connects_v2.filter"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDirectConnectV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectConnectsV2Datasource_basic(directConnectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "direct_connects.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "direct_connects.0.name", directConnectName),
+					resource.TestCheckResourceAttr(dataSourceName, "direct_connects.0.bandwidth", "100"),
+					resource.TestCheckResourceAttr(dataSourceName, "direct_connects.0.location", "Biere"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "direct_connects.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDirectConnectsV2Datasource_basic(directConnectName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_direct_connect_v2" "direct_connect" {
+  name          = "%s"
+  port_type     = "1G"
+  location      = "Biere"
+  bandwidth     = 100
+  provider_name = "OTC"
+}
+
+data "opentelekomcloud_direct_connects_v2" "filter" {
+  direct_connect_id = opentelekomcloud_direct_connect_v2.direct_connect.id
+}
+`, directConnectName)
+}
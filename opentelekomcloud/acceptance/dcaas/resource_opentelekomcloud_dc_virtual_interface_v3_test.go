@@ -3,6 +3,7 @@ package dcaas
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -121,6 +122,56 @@ func TestAccVirtualInterface_basic(t *testing.T) {
 	})
 }
 
+func TestAccVirtualInterface_networkDetection(t *testing.T) {
+	dcId := os.Getenv("OS_DIRECT_CONNECT_ID")
+	if dcId == "" {
+		t.Skip("OS_DIRECT_CONNECT_ID should be set for acceptance tests")
+	}
+	var (
+		vi virtual_interface.VirtualInterface
+
+		rName = "opentelekomcloud_dc_virtual_interface_v3.vi"
+		name  = fmt.Sprintf("dc_acc_vi%s", acctest.RandString(5))
+		vlan  = acctest.RandIntRange(1, 3999)
+	)
+
+	rc := common.InitResourceCheck(
+		rName,
+		&vi,
+		getVirtualInterfaceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualInterface_networkDetection(name, vlan, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "enable_bfd", "true"),
+					resource.TestCheckResourceAttr(rName, "enable_nqa", "false"),
+				),
+			},
+			{
+				Config: testAccVirtualInterface_networkDetection(name, vlan, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "enable_bfd", "false"),
+					resource.TestCheckResourceAttr(rName, "enable_nqa", "true"),
+				),
+			},
+			{
+				Config:      testAccVirtualInterface_networkDetection(name, vlan, true, true),
+				ExpectError: regexp.MustCompile("BFD and NQA cannot be enabled at the same time"),
+			},
+		},
+	})
+}
+
 func testAccVirtualInterface_base(name string) string {
 	return fmt.Sprintf(`
 %s
@@ -186,3 +237,29 @@ resource "opentelekomcloud_dc_virtual_interface_v3" "vi" {
 }
 `, testAccVirtualInterface_base(name), os.Getenv("OS_DIRECT_CONNECT_ID"), name, vlan)
 }
+
+func testAccVirtualInterface_networkDetection(name string, vlan int, bfd, nqa bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "opentelekomcloud_dc_virtual_interface_v3" "vi" {
+  direct_connect_id = "%[2]s"
+  vgw_id            = opentelekomcloud_dc_virtual_gateway_v3.gw.id
+  name              = "%[3]s"
+  type              = "private"
+  route_mode        = "static"
+  vlan              = %[4]d
+  bandwidth         = 5
+  enable_bfd        = %[5]t
+  enable_nqa        = %[6]t
+
+  remote_ep_group = [
+    "1.1.1.0/30",
+  ]
+
+  address_family       = "ipv4"
+  local_gateway_v4_ip  = "1.1.1.1/30"
+  remote_gateway_v4_ip = "1.1.1.2/30"
+}
+`, testAccVirtualInterface_base(name), os.Getenv("OS_DIRECT_CONNECT_ID"), name, vlan, bfd, nqa)
+}
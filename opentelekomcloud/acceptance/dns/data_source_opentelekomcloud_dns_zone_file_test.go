@@ -0,0 +1,57 @@
+package acceptance
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataSourceZoneFileName = "data.opentelekomcloud_dns_zone_file.zone_file"
+
+func TestAccDNSZoneFileDataSource_basic(t *testing.T) {
+	zoneName := randomZoneName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDNSV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneFileDataSourceBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceZoneFileName, "name", zoneName),
+					resource.TestMatchResourceAttr(dataSourceZoneFileName, "content",
+						regexp.MustCompile(`(?m)^\$ORIGIN `+regexp.QuoteMeta(zoneName)+`$`)),
+					resource.TestMatchResourceAttr(dataSourceZoneFileName, "content",
+						regexp.MustCompile(`www\.`+regexp.QuoteMeta(zoneName)+`\s+3000\s+IN\s+A\s+10\.0\.0\.1`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSZoneFileDataSourceBasic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name  = "%[1]s"
+  email = "email2@example.com"
+  ttl   = 6000
+}
+
+resource "opentelekomcloud_dns_recordset_v2" "recordset_1" {
+  zone_id = opentelekomcloud_dns_zone_v2.zone_1.id
+  name    = "www.%[1]s"
+  type    = "A"
+  ttl     = 3000
+  records = ["10.0.0.1"]
+}
+
+data "opentelekomcloud_dns_zone_file" "zone_file" {
+  zone_id = opentelekomcloud_dns_recordset_v2.recordset_1.zone_id
+}
+`, zoneName)
+}
@@ -190,6 +190,42 @@ func TestAccDNSV2RecordSet_txt(t *testing.T) {
 	})
 }
 
+func TestAccDNSV2RecordSet_weighted(t *testing.T) {
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDNSV2RecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2RecordSetWeighted(zoneName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRecordSetName, "line", "default_view"),
+					resource.TestCheckResourceAttr(resourceRecordSetName, "weight", "10"),
+				),
+			},
+			{
+				Config: testAccDNSV2RecordSetWeighted(zoneName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRecordSetName, "weight", "20"),
+				),
+			},
+			{
+				Config: testAccDNSV2RecordSetWeighted(zoneName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRecordSetName, "weight", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceRecordSetName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDNSV2RecordSetDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	client, err := config.DnsV2Client(env.OS_REGION_NAME)
@@ -479,3 +515,25 @@ resource "opentelekomcloud_dns_recordset_v2" "recordset_1" {
 }
 `, zoneName)
 }
+
+func testAccDNSV2RecordSetWeighted(zoneName string, weight int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name        = "%[1]s"
+  email       = "email2@example.com"
+  description = "a zone"
+  ttl         = 6000
+}
+
+resource "opentelekomcloud_dns_recordset_v2" "recordset_1" {
+  zone_id     = opentelekomcloud_dns_zone_v2.zone_1.id
+  name        = "www.%[1]s"
+  type        = "A"
+  description = "a weighted record set"
+  ttl         = 300
+  records     = ["10.1.0.0"]
+  line        = "default_view"
+  weight      = %[2]d
+}
+`, zoneName, weight)
+}
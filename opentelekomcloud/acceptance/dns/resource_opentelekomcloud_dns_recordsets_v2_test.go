@@ -0,0 +1,191 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceRecordSetsName = "opentelekomcloud_dns_recordsets_v2.recordsets"
+
+func TestAccDNSV2RecordSets_basic(t *testing.T) {
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDNSV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2RecordSetsBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRecordSetsName, "recordset.#", "3"),
+				),
+			},
+			{
+				Config: testAccDNSV2RecordSetsUpdate(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRecordSetsName, "recordset.#", "2"),
+				),
+			},
+			{
+				Config: testAccDNSV2RecordSetsLine(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					// same name and type in different lines are separate record sets
+					resource.TestCheckResourceAttr(resourceRecordSetsName, "recordset.#", "3"),
+				),
+			},
+			{
+				Config: testAccDNSV2RecordSetsWeighted(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					// weighted record sets with the same name, type and line are kept
+					resource.TestCheckResourceAttr(resourceRecordSetsName, "recordset.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceRecordSetsName, "recordset.*", map[string]string{
+						"records.0": "10.1.0.1",
+						"weight":    "3",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceRecordSetsName, "recordset.*", map[string]string{
+						"records.0": "10.1.0.2",
+						"weight":    "0",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceRecordSetsName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDNSV2RecordSetsBasic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name  = "%[1]s"
+  email = "email2@example.com"
+  ttl   = 6000
+}
+
+resource "opentelekomcloud_dns_recordsets_v2" "recordsets" {
+  zone_id = opentelekomcloud_dns_zone_v2.zone_1.id
+
+  recordset {
+    name    = "www.%[1]s"
+    type    = "A"
+    records = ["10.1.0.1", "10.1.0.2"]
+  }
+
+  recordset {
+    name    = "mail.%[1]s"
+    type    = "A"
+    ttl     = 3000
+    records = ["10.1.0.3"]
+  }
+
+  recordset {
+    name    = "%[1]s"
+    type    = "TXT"
+    records = ["v=spf1 include:my.example.try.com -all"]
+  }
+}
+`, zoneName)
+}
+
+func testAccDNSV2RecordSetsUpdate(zoneName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name  = "%[1]s"
+  email = "email2@example.com"
+  ttl   = 6000
+}
+
+resource "opentelekomcloud_dns_recordsets_v2" "recordsets" {
+  zone_id = opentelekomcloud_dns_zone_v2.zone_1.id
+
+  recordset {
+    name        = "www.%[1]s"
+    type        = "A"
+    description = "updated"
+    records     = ["10.1.0.1"]
+  }
+
+  recordset {
+    name    = "%[1]s"
+    type    = "MX"
+    records = ["10 mail.%[1]s"]
+  }
+}
+`, zoneName)
+}
+
+func testAccDNSV2RecordSetsLine(zoneName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name  = "%[1]s"
+  email = "email2@example.com"
+  ttl   = 6000
+}
+
+resource "opentelekomcloud_dns_recordsets_v2" "recordsets" {
+  zone_id = opentelekomcloud_dns_zone_v2.zone_1.id
+
+  recordset {
+    name        = "www.%[1]s"
+    type        = "A"
+    description = "updated"
+    records     = ["10.1.0.1"]
+  }
+
+  recordset {
+    name    = "www.%[1]s"
+    type    = "A"
+    line    = "Abroad"
+    records = ["10.1.0.5"]
+  }
+
+  recordset {
+    name    = "%[1]s"
+    type    = "MX"
+    records = ["10 mail.%[1]s"]
+  }
+}
+`, zoneName)
+}
+
+func testAccDNSV2RecordSetsWeighted(zoneName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name  = "%[1]s"
+  email = "email2@example.com"
+  ttl   = 6000
+}
+
+resource "opentelekomcloud_dns_recordsets_v2" "recordsets" {
+  zone_id = opentelekomcloud_dns_zone_v2.zone_1.id
+
+  recordset {
+    name    = "www.%[1]s"
+    type    = "A"
+    weight  = 3
+    records = ["10.1.0.1"]
+  }
+
+  recordset {
+    name    = "www.%[1]s"
+    type    = "A"
+    weight  = 0
+    records = ["10.1.0.2"]
+  }
+
+  recordset {
+    name    = "%[1]s"
+    type    = "MX"
+    records = ["10 mail.%[1]s"]
+  }
+}
+`, zoneName)
+}
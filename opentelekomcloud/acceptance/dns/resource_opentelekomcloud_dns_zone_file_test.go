@@ -0,0 +1,77 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceZoneFileName = "opentelekomcloud_dns_zone_file.zone_file"
+
+func TestAccDNSZoneFile_basic(t *testing.T) {
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDNSV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneFileBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceZoneFileName, "recordset_count", "3"),
+				),
+			},
+			{
+				Config: testAccDNSZoneFileUpdate(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceZoneFileName, "recordset_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSZoneFileBasic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name  = "%[1]s"
+  email = "email2@example.com"
+  ttl   = 6000
+}
+
+resource "opentelekomcloud_dns_zone_file" "zone_file" {
+  zone_id = opentelekomcloud_dns_zone_v2.zone_1.id
+  content = <<EOT
+$ORIGIN %[1]s
+$TTL 300
+www     IN  A    10.0.0.1
+        IN  A    10.0.0.2
+mail    IN  A    10.0.0.3 ; mail server
+@       IN  MX   10 mail
+EOT
+}
+`, zoneName)
+}
+
+func testAccDNSZoneFileUpdate(zoneName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name  = "%[1]s"
+  email = "email2@example.com"
+  ttl   = 6000
+}
+
+resource "opentelekomcloud_dns_zone_file" "zone_file" {
+  zone_id = opentelekomcloud_dns_zone_v2.zone_1.id
+  content = <<EOT
+$ORIGIN %[1]s
+www     600 IN  A    10.0.0.1
+@       300 IN  TXT  "v=spf1 -all"
+EOT
+}
+`, zoneName)
+}
@@ -0,0 +1,70 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const resourceZoneAssociationName = "opentelekomcloud_dns_zone_router_association_v2.association"
+
+func TestAccDNSV2ZoneRouterAssociation_basic(t *testing.T) {
+	zoneName := randomZoneName()
+	quotas.BookOne(t, quotas.Router)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDNSV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2ZoneRouterAssociationBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceZoneAssociationName, "router_id",
+						"opentelekomcloud_vpc_v1.vpc", "id"),
+					resource.TestCheckResourceAttr(resourceZoneAssociationName, "router_region", env.OS_REGION_NAME),
+					resource.TestCheckResourceAttr(resourceZoneAssociationName, "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceZoneAssociationName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDNSV2ZoneRouterAssociationBasic(zoneName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpc_v1" "vpc" {
+  name = "dns-association-vpc"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name        = "%s"
+  email       = "email1@example.com"
+  description = "a private zone"
+  ttl         = 3000
+  type        = "private"
+
+  router {
+    router_id     = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.vpc_id
+    router_region = "%s"
+  }
+}
+
+resource "opentelekomcloud_dns_zone_router_association_v2" "association" {
+  zone_id   = opentelekomcloud_dns_zone_v2.zone_1.id
+  router_id = opentelekomcloud_vpc_v1.vpc.id
+}
+`, common.DataSourceSubnet, zoneName, env.OS_REGION_NAME)
+}
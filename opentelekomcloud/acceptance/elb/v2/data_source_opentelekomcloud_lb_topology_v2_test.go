@@ -0,0 +1,121 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+)
+
+const dataTopologyName = "data.opentelekomcloud_lb_topology_v2.this"
+
+func TestAccLBTopologyV2DataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			qts := quotas.MultipleQuotas{
+				{Q: quotas.LoadBalancer, Count: 1},
+				{Q: quotas.LbListener, Count: 1},
+				{Q: quotas.LbPool, Count: 1},
+				{Q: quotas.LbPolicy, Count: 1},
+			}
+			quotas.BookMany(t, qts)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBTopologyV2DataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataTopologyName, "loadbalancer.0.name", "loadbalancer_1"),
+					resource.TestCheckResourceAttrSet(dataTopologyName, "loadbalancer.0.router_id"),
+					resource.TestCheckResourceAttr(dataTopologyName, "loadbalancer.0.network_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataTopologyName, "listeners.#", "1"),
+					resource.TestCheckResourceAttr(dataTopologyName, "listeners.0.protocol", "HTTP"),
+					resource.TestCheckResourceAttr(dataTopologyName, "listeners.0.ip_group.0.enable", "true"),
+					resource.TestCheckResourceAttr(dataTopologyName, "listeners.0.ip_group.0.type", "white"),
+					resource.TestCheckResourceAttr(dataTopologyName, "ipgroups.#", "1"),
+					resource.TestCheckResourceAttr(dataTopologyName, "ipgroups.0.name", "listener_1-whitelist"),
+					resource.TestCheckResourceAttr(dataTopologyName, "ipgroups.0.ip_list.#", "2"),
+					resource.TestCheckResourceAttr(dataTopologyName, "pools.#", "1"),
+					resource.TestCheckResourceAttr(dataTopologyName, "pools.0.members.#", "1"),
+					resource.TestCheckResourceAttr(dataTopologyName, "pools.0.members.0.protocol_port", "8080"),
+					resource.TestCheckResourceAttr(dataTopologyName, "pools.0.monitor.0.type", "HTTP"),
+					resource.TestCheckResourceAttr(dataTopologyName, "policies.#", "1"),
+					resource.TestCheckResourceAttr(dataTopologyName, "policies.0.rules.#", "1"),
+					resource.TestCheckResourceAttr(dataTopologyName, "policies.0.rules.0.type", "PATH"),
+				),
+			},
+		},
+	})
+}
+
+var testAccLBTopologyV2DataSourceBasic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.subnet_id
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "opentelekomcloud_lb_whitelist_v2" "whitelist_1" {
+  enable_whitelist = true
+  whitelist        = "192.168.11.1,192.168.0.1/24"
+  listener_id      = opentelekomcloud_lb_listener_v2.listener_1.id
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_1" {
+  name        = "pool_1"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = opentelekomcloud_lb_listener_v2.listener_1.id
+}
+
+resource "opentelekomcloud_lb_member_v2" "member_1" {
+  address       = cidrhost(data.opentelekomcloud_vpc_subnet_v1.shared_subnet.cidr, 10)
+  protocol_port = 8080
+  pool_id       = opentelekomcloud_lb_pool_v2.pool_1.id
+  subnet_id     = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.subnet_id
+}
+
+resource "opentelekomcloud_lb_monitor_v2" "monitor_1" {
+  pool_id     = opentelekomcloud_lb_pool_v2.pool_1.id
+  type        = "HTTP"
+  delay       = 20
+  timeout     = 10
+  max_retries = 5
+  url_path    = "/"
+}
+
+resource "opentelekomcloud_lb_l7policy_v2" "l7policy_1" {
+  action           = "REDIRECT_TO_POOL"
+  listener_id      = opentelekomcloud_lb_listener_v2.listener_1.id
+  redirect_pool_id = opentelekomcloud_lb_pool_v2.pool_1.id
+}
+
+resource "opentelekomcloud_lb_l7rule_v2" "l7rule_1" {
+  l7policy_id  = opentelekomcloud_lb_l7policy_v2.l7policy_1.id
+  type         = "PATH"
+  compare_type = "EQUAL_TO"
+  value        = "/api"
+}
+
+data "opentelekomcloud_lb_topology_v2" "this" {
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+
+  depends_on = [
+    opentelekomcloud_lb_whitelist_v2.whitelist_1,
+    opentelekomcloud_lb_member_v2.member_1,
+    opentelekomcloud_lb_monitor_v2.monitor_1,
+    opentelekomcloud_lb_l7rule_v2.l7rule_1,
+  ]
+}
+`, common.DataSourceSubnet)
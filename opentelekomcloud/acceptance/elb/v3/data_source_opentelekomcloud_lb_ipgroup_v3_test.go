@@ -0,0 +1,48 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const (
+	dataSourceIpGroupName  = "data.opentelekomcloud_lb_ipgroup_v3.group"
+	dataSourceIpGroupsName = "data.opentelekomcloud_lb_ipgroups_v3.groups"
+)
+
+func TestDataSourceLBIpGroupV3_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLBIpGroupV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceIpGroupName, "id", resourceIpGroupName, "id"),
+					resource.TestCheckResourceAttr(dataSourceIpGroupName, "description", "some interesting description"),
+					resource.TestCheckResourceAttr(dataSourceIpGroupName, "ip_list.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceIpGroupsName, "ipgroups.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceIpGroupsName, "ipgroups.0.id", resourceIpGroupName, "id"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceLBIpGroupV3Basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_ipgroup_v3" "group" {
+  id = opentelekomcloud_lb_ipgroup_v3.group_1.id
+}
+
+data "opentelekomcloud_lb_ipgroups_v3" "groups" {
+  name       = opentelekomcloud_lb_ipgroup_v3.group_1.name
+  ip_address = "192.168.10.10"
+}
+`, testAccLBV3IpGroupConfigBasic)
@@ -0,0 +1,56 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+)
+
+const (
+	dataSourceMemberName  = "data.opentelekomcloud_lb_member_v3.member"
+	dataSourceMembersName = "data.opentelekomcloud_lb_members_v3.members"
+)
+
+func TestDataSourceLBMemberV3_basic(t *testing.T) {
+	t.Parallel()
+	qts := []*quotas.ExpectedQuota{
+		{Q: quotas.LbPool, Count: 1},
+		{Q: quotas.LoadBalancer, Count: 1},
+	}
+	quotas.BookMany(t, qts)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLBMemberV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceMemberName, "name", "member-1"),
+					resource.TestCheckResourceAttr(dataSourceMemberName, "protocol_port", "8080"),
+					resource.TestCheckResourceAttr(dataSourceMemberName, "weight", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceMemberName, "operating_status"),
+					resource.TestCheckResourceAttr(dataSourceMembersName, "members.#", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceMembersName, "members.0.operating_status"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceLBMemberV3Basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_member_v3" "member" {
+  pool_id = opentelekomcloud_lb_members_v3.members.pool_id
+  name    = "member-1"
+}
+
+data "opentelekomcloud_lb_members_v3" "members" {
+  pool_id       = opentelekomcloud_lb_members_v3.members.pool_id
+  protocol_port = 8080
+}
+`, testLBMembersV3Basic)
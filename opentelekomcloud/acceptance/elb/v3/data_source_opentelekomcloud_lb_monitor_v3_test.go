@@ -0,0 +1,55 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+)
+
+const (
+	dataSourceMonitorName  = "data.opentelekomcloud_lb_monitor_v3.monitor"
+	dataSourceMonitorsName = "data.opentelekomcloud_lb_monitors_v3.monitors"
+)
+
+func TestDataSourceLBMonitorV3_basic(t *testing.T) {
+	t.Parallel()
+	qts := quotas.MultipleQuotas{
+		{Q: quotas.LoadBalancer, Count: 1},
+		{Q: quotas.LbPool, Count: 1},
+	}
+	quotas.BookMany(t, qts)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLBMonitorV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceMonitorName, "id", resourceMonitorName, "id"),
+					resource.TestCheckResourceAttr(dataSourceMonitorName, "type", "HTTP"),
+					resource.TestCheckResourceAttr(dataSourceMonitorName, "delay", "3"),
+					resource.TestCheckResourceAttr(dataSourceMonitorName, "monitor_port", "8080"),
+					resource.TestCheckResourceAttr(dataSourceMonitorsName, "monitors.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceMonitorsName, "monitors.0.id", resourceMonitorName, "id"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceLBMonitorV3Basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_monitor_v3" "monitor" {
+  pool_id = opentelekomcloud_lb_monitor_v3.monitor.pool_id
+}
+
+data "opentelekomcloud_lb_monitors_v3" "monitors" {
+  pool_id = opentelekomcloud_lb_monitor_v3.monitor.pool_id
+  type    = "HTTP"
+}
+`, testResourceMonitorBasic)
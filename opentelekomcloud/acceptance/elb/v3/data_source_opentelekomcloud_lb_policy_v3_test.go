@@ -0,0 +1,60 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+)
+
+const (
+	dataSourcePolicyName   = "data.opentelekomcloud_lb_policy_v3.policy"
+	dataSourcePoliciesName = "data.opentelekomcloud_lb_policies_v3.policies"
+)
+
+func TestDataSourceLBPolicyV3_basic(t *testing.T) {
+	t.Parallel()
+	qts := quotas.MultipleQuotas{
+		{Q: quotas.LoadBalancer, Count: 1},
+		{Q: quotas.LbListener, Count: 1},
+		{Q: quotas.LbPool, Count: 1},
+		{Q: quotas.LbPolicy, Count: 1},
+	}
+	quotas.BookMany(t, qts)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLBPolicyV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourcePolicyName, "id", "opentelekomcloud_lb_policy_v3.this", "id"),
+					resource.TestCheckResourceAttr(dataSourcePolicyName, "action", "REDIRECT_TO_POOL"),
+					resource.TestCheckResourceAttr(dataSourcePolicyName, "rule_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourcePolicyName, "redirect_pool_id", "opentelekomcloud_lb_pool_v3.this", "id"),
+					resource.TestCheckResourceAttr(dataSourcePoliciesName, "policies.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceLBPolicyV3Basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_policy_v3" "policy" {
+  listener_id = opentelekomcloud_lb_listener_v3.this.id
+
+  depends_on = [opentelekomcloud_lb_rule_v3.this]
+}
+
+data "opentelekomcloud_lb_policies_v3" "policies" {
+  listener_id = opentelekomcloud_lb_listener_v3.this.id
+  action      = "REDIRECT_TO_POOL"
+
+  depends_on = [opentelekomcloud_lb_policy_v3.this]
+}
+`, testAccLBV3RuleConfigBasic)
@@ -0,0 +1,59 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+)
+
+const (
+	dataSourcePoolName  = "data.opentelekomcloud_lb_pool_v3.pool"
+	dataSourcePoolsName = "data.opentelekomcloud_lb_pools_v3.pools"
+)
+
+func TestDataSourceLBPoolV3_basic(t *testing.T) {
+	t.Parallel()
+	qts := quotas.MultipleQuotas{
+		{Q: quotas.LoadBalancer, Count: 1},
+		{Q: quotas.LbPool, Count: 1},
+	}
+	quotas.BookMany(t, qts)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLBPoolV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourcePoolName, "id", "opentelekomcloud_lb_pool_v3.pool", "id"),
+					resource.TestCheckResourceAttr(dataSourcePoolName, "protocol", "HTTP"),
+					resource.TestCheckResourceAttr(dataSourcePoolName, "lb_algorithm", "ROUND_ROBIN"),
+					resource.TestCheckResourceAttrPair(dataSourcePoolName, "healthmonitor_id", "opentelekomcloud_lb_monitor_v3.monitor", "id"),
+					resource.TestCheckResourceAttr(dataSourcePoolsName, "pools.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourcePoolsName, "pools.0.id", "opentelekomcloud_lb_pool_v3.pool", "id"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceLBPoolV3Basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_pool_v3" "pool" {
+  id = opentelekomcloud_lb_pool_v3.pool.id
+
+  depends_on = [opentelekomcloud_lb_monitor_v3.monitor]
+}
+
+data "opentelekomcloud_lb_pools_v3" "pools" {
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.lb.id
+  protocol        = "HTTP"
+
+  depends_on = [opentelekomcloud_lb_pool_v3.pool]
+}
+`, testResourceMonitorBasic)
@@ -0,0 +1,56 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+)
+
+const (
+	dataSourceRuleName  = "data.opentelekomcloud_lb_rule_v3.rule"
+	dataSourceRulesName = "data.opentelekomcloud_lb_rules_v3.rules"
+)
+
+func TestDataSourceLBRuleV3_basic(t *testing.T) {
+	t.Parallel()
+	qts := quotas.MultipleQuotas{
+		{Q: quotas.LoadBalancer, Count: 1},
+		{Q: quotas.LbListener, Count: 1},
+		{Q: quotas.LbPool, Count: 1},
+		{Q: quotas.LbPolicy, Count: 1},
+	}
+	quotas.BookMany(t, qts)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLBRuleV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceRuleName, "id", resourceRuleName, "rule_id"),
+					resource.TestCheckResourceAttr(dataSourceRuleName, "type", "PATH"),
+					resource.TestCheckResourceAttr(dataSourceRuleName, "compare_type", "REGEX"),
+					resource.TestCheckResourceAttr(dataSourceRuleName, "value", "^.+$"),
+					resource.TestCheckResourceAttr(dataSourceRulesName, "rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceLBRuleV3Basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_rule_v3" "rule" {
+  policy_id = opentelekomcloud_lb_rule_v3.this.policy_id
+  type      = "PATH"
+}
+
+data "opentelekomcloud_lb_rules_v3" "rules" {
+  policy_id = opentelekomcloud_lb_rule_v3.this.policy_id
+}
+`, testAccLBV3RuleConfigBasic)
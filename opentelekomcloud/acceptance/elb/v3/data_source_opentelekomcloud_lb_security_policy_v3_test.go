@@ -0,0 +1,48 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const (
+	dataSourceSecurityPolicyName   = "data.opentelekomcloud_lb_security_policy_v3.policy"
+	dataSourceSecurityPoliciesName = "data.opentelekomcloud_lb_security_policies_v3.policies"
+)
+
+func TestDataSourceLBSecurityPolicyV3_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLBSecurityPolicyV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceSecurityPolicyName, "id", resourceSecurityPolicyName, "id"),
+					resource.TestCheckResourceAttr(dataSourceSecurityPolicyName, "description", "test-description"),
+					resource.TestCheckResourceAttr(dataSourceSecurityPolicyName, "protocols.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceSecurityPolicyName, "ciphers.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceSecurityPoliciesName, "security_policies.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceLBSecurityPolicyV3Basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_security_policy_v3" "policy" {
+  name = opentelekomcloud_lb_security_policy_v3.this.name
+}
+
+data "opentelekomcloud_lb_security_policies_v3" "policies" {
+  name     = opentelekomcloud_lb_security_policy_v3.this.name
+  protocol = "TLSv1.1"
+}
+`, testAccLBV3SecurityPolicyConfigBasic)
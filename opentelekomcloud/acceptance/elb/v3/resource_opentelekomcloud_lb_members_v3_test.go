@@ -0,0 +1,194 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/members"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	elbv3 "github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/elb/v3"
+)
+
+const resourceMembersName = "opentelekomcloud_lb_members_v3.members"
+
+func TestLBMembersV3_basic(t *testing.T) {
+	t.Parallel()
+	qts := []*quotas.ExpectedQuota{
+		{Q: quotas.LbPool, Count: 1},
+		{Q: quotas.LoadBalancer, Count: 1},
+	}
+	quotas.BookMany(t, qts)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testLBMembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testLBMembersV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testLBMembersV3Count(resourceMembersName, 2),
+					resource.TestCheckResourceAttr(resourceMembersName, "members.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceMembersName, "members.*", map[string]string{
+						"name":          "member-1",
+						"protocol_port": "8080",
+						"weight":        "1",
+					}),
+				),
+			},
+			{
+				Config: testLBMembersV3Updated,
+				Check: resource.ComposeTestCheckFunc(
+					testLBMembersV3Count(resourceMembersName, 2),
+					resource.TestCheckResourceAttr(resourceMembersName, "members.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceMembersName, "members.*", map[string]string{
+						"name":   "member-1-updated",
+						"weight": "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceMembersName, "members.*", map[string]string{
+						"name":          "member-3",
+						"protocol_port": "8081",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceMembersName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testLBMembersV3Count(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.ElbV3Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf(elbv3.ErrCreateClient, err)
+		}
+
+		pages, err := members.List(client, rs.Primary.ID, members.ListOpts{}).AllPages()
+		if err != nil {
+			return err
+		}
+		found, err := members.ExtractMembers(pages)
+		if err != nil {
+			return err
+		}
+		if len(found) != expected {
+			return fmt.Errorf("expected %d pool members, got %d", expected, len(found))
+		}
+		return nil
+	}
+}
+
+func testLBMembersDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.ElbV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf(elbv3.ErrCreateClient, err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_members_v3" {
+			continue
+		}
+
+		pages, err := members.List(client, rs.Primary.ID, members.ListOpts{}).AllPages()
+		if err != nil {
+			// the pool is deleted together with the members
+			continue
+		}
+		found, err := members.ExtractMembers(pages)
+		if err != nil {
+			return err
+		}
+		if len(found) != 0 {
+			return fmt.Errorf("loadbalancer pool members still exist: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testLBMembersV3Basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_loadbalancer_v3" "lb" {
+  name        = "loadbalancer_1"
+  router_id   = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.vpc_id
+  network_ids = [data.opentelekomcloud_vpc_subnet_v1.shared_subnet.network_id]
+
+  ip_target_enable = true
+
+  availability_zones = ["%s"]
+}
+
+resource "opentelekomcloud_lb_pool_v3" "pool" {
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.lb.id
+  lb_algorithm    = "ROUND_ROBIN"
+  protocol        = "TCP"
+}
+
+resource "opentelekomcloud_lb_members_v3" "members" {
+  pool_id = opentelekomcloud_lb_pool_v3.pool.id
+
+  members {
+    name          = "member-1"
+    address       = cidrhost(data.opentelekomcloud_vpc_subnet_v1.shared_subnet.cidr, 3)
+    protocol_port = 8080
+  }
+  members {
+    name          = "member-2"
+    address       = cidrhost(data.opentelekomcloud_vpc_subnet_v1.shared_subnet.cidr, 4)
+    protocol_port = 8080
+  }
+}
+`, common.DataSourceSubnet, env.OS_AVAILABILITY_ZONE)
+
+var testLBMembersV3Updated = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_loadbalancer_v3" "lb" {
+  name        = "loadbalancer_1"
+  router_id   = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.vpc_id
+  network_ids = [data.opentelekomcloud_vpc_subnet_v1.shared_subnet.network_id]
+
+  ip_target_enable = true
+
+  availability_zones = ["%s"]
+}
+
+resource "opentelekomcloud_lb_pool_v3" "pool" {
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.lb.id
+  lb_algorithm    = "ROUND_ROBIN"
+  protocol        = "TCP"
+}
+
+resource "opentelekomcloud_lb_members_v3" "members" {
+  pool_id = opentelekomcloud_lb_pool_v3.pool.id
+
+  members {
+    name          = "member-1-updated"
+    address       = cidrhost(data.opentelekomcloud_vpc_subnet_v1.shared_subnet.cidr, 3)
+    protocol_port = 8080
+    weight        = 0
+  }
+  members {
+    name          = "member-3"
+    address       = cidrhost(data.opentelekomcloud_vpc_subnet_v1.shared_subnet.cidr, 5)
+    protocol_port = 8081
+  }
+}
+`, common.DataSourceSubnet, env.OS_AVAILABILITY_ZONE)
@@ -28,6 +28,8 @@ var (
 	OS_DDM_ID            = os.Getenv("OS_DDM_ID")
 	OS_RDS_ID            = os.Getenv("OS_RDS_ID")
 	OS_APIGW_GATEWAY_ID  = os.Getenv("OS_APIGW_GATEWAY_ID")
+	OS_ER_INSTANCE_ID    = os.Getenv("OS_ER_INSTANCE_ID")
+	OS_ER_DC_VGW_ID      = os.Getenv("OS_ER_DC_VGW_ID")
 )
 
 func flavorID() string {
@@ -0,0 +1,70 @@
+package er
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceErAttachmentsV3_basic(t *testing.T) {
+	var (
+		dataSourceName = "data.opentelekomcloud_er_attachments_v3.test"
+		name           = fmt.Sprintf("er-acc-api%s", acctest.RandString(5))
+		bgpAsNum       = acctest.RandIntRange(64512, 65534)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceErAttachmentsV3_basic(name, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "attachments.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attachments.0.id",
+						"opentelekomcloud_er_vpc_attachment_v3.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "attachments.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "attachments.0.type", "vpc"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attachments.0.resource_id",
+						"opentelekomcloud_vpc_v1.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "attachments.0.status", "available"),
+					resource.TestCheckOutput("vpn_filter_is_useful", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceErAttachmentsV3_basic(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "opentelekomcloud_er_vpc_attachment_v3" "test" {
+  instance_id = opentelekomcloud_er_instance_v3.test.id
+  vpc_id      = opentelekomcloud_vpc_v1.test.id
+  subnet_id   = opentelekomcloud_vpc_subnet_v1.test.id
+  name        = "%[2]s"
+}
+
+data "opentelekomcloud_er_attachments_v3" "test" {
+  instance_id = opentelekomcloud_er_instance_v3.test.id
+  type        = "vpc"
+
+  depends_on = [opentelekomcloud_er_vpc_attachment_v3.test]
+}
+
+data "opentelekomcloud_er_attachments_v3" "vpn" {
+  instance_id = opentelekomcloud_er_instance_v3.test.id
+  type        = "vpn"
+
+  depends_on = [opentelekomcloud_er_vpc_attachment_v3.test]
+}
+
+output "vpn_filter_is_useful" {
+  value = length(data.opentelekomcloud_er_attachments_v3.vpn.attachments) == 0
+}
+`, testVpcAttachment_base(name, bgpAsNum), name)
+}
@@ -0,0 +1,85 @@
+package er
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceErEffectiveRoutesV3_basic(t *testing.T) {
+	var (
+		dataSourceName = "data.opentelekomcloud_er_effective_routes_v3.test"
+		name           = fmt.Sprintf("er-acc-api%s", acctest.RandString(5))
+		bgpAsNum       = acctest.RandIntRange(64512, 65534)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceErEffectiveRoutesV3_basic(name, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "routes.#", "2"),
+					resource.TestCheckOutput("propagated_route_is_found", "true"),
+					resource.TestCheckOutput("blackhole_route_is_found", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceErEffectiveRoutesV3_basic(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "opentelekomcloud_er_vpc_attachment_v3" "test" {
+  instance_id = opentelekomcloud_er_instance_v3.test.id
+  vpc_id      = opentelekomcloud_vpc_v1.test.id
+  subnet_id   = opentelekomcloud_vpc_subnet_v1.test.id
+  name        = "%[2]s"
+}
+
+resource "opentelekomcloud_er_route_table_v3" "test" {
+  instance_id = opentelekomcloud_er_instance_v3.test.id
+  name        = "%[2]s"
+}
+
+resource "opentelekomcloud_er_propagation_v3" "test" {
+  instance_id    = opentelekomcloud_er_instance_v3.test.id
+  route_table_id = opentelekomcloud_er_route_table_v3.test.id
+  attachment_id  = opentelekomcloud_er_vpc_attachment_v3.test.id
+}
+
+resource "opentelekomcloud_er_blackhole_routes_v3" "test" {
+  route_table_id = opentelekomcloud_er_route_table_v3.test.id
+  destinations   = ["10.10.0.0/16"]
+}
+
+data "opentelekomcloud_er_effective_routes_v3" "test" {
+  route_table_id = opentelekomcloud_er_route_table_v3.test.id
+
+  depends_on = [
+    opentelekomcloud_er_propagation_v3.test,
+    opentelekomcloud_er_blackhole_routes_v3.test,
+  ]
+}
+
+output "propagated_route_is_found" {
+  value = length([
+    for r in data.opentelekomcloud_er_effective_routes_v3.test.routes : r
+    if r.destination == opentelekomcloud_vpc_v1.test.cidr && r.next_hops[0].resource_type == "vpc"
+  ]) == 1
+}
+
+output "blackhole_route_is_found" {
+  value = length([
+    for r in data.opentelekomcloud_er_effective_routes_v3.test.routes : r
+    if r.destination == "10.10.0.0/16" && r.is_blackhole
+  ]) == 1
+}
+`, testVpcAttachment_base(name, bgpAsNum), name)
+}
@@ -0,0 +1,64 @@
+package er
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceErInstancesV3_basic(t *testing.T) {
+	var (
+		dataSourceName = "data.opentelekomcloud_er_instances_v3.test"
+		name           = fmt.Sprintf("er-acc-api%s", acctest.RandString(5))
+		bgpAsNum       = acctest.RandIntRange(64512, 65534)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceErInstancesV3_basic(name, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.id",
+						"opentelekomcloud_er_instance_v3.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.asn", fmt.Sprint(bgpAsNum)),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.status", "available"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.availability_zones.#", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.default_association_route_table_id"),
+					resource.TestCheckOutput("id_filter_is_useful", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceErInstancesV3_basic(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_er_instance_v3" "test" {
+  availability_zones = ["eu-de-01", "eu-de-02"]
+
+  name                       = "%[1]s"
+  asn                        = %[2]d
+  enable_default_association = true
+  enable_default_propagation = true
+}
+
+data "opentelekomcloud_er_instances_v3" "test" {
+  name = opentelekomcloud_er_instance_v3.test.name
+}
+
+data "opentelekomcloud_er_instances_v3" "by_id" {
+  instance_id = opentelekomcloud_er_instance_v3.test.id
+}
+
+output "id_filter_is_useful" {
+  value = length(data.opentelekomcloud_er_instances_v3.by_id.instances) == 1
+}
+`, name, bgpAsNum)
+}
@@ -0,0 +1,101 @@
+package er
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/er/v3/route"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func TestAccBlackholeRoutesV3_basic(t *testing.T) {
+	var (
+		rName    = "opentelekomcloud_er_blackhole_routes_v3.test"
+		name     = fmt.Sprintf("er-acc-api%s", acctest.RandString(5))
+		bgpAsNum = acctest.RandIntRange(64512, 65534)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckBlackholeRoutesV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlackholeRoutesV3_basic(name, bgpAsNum, `"10.10.0.0/16", "10.20.0.0/16"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rName, "route_table_id",
+						"opentelekomcloud_er_route_table_v3.test", "id"),
+					resource.TestCheckResourceAttr(rName, "destinations.#", "2"),
+					resource.TestCheckResourceAttr(rName, "routes.#", "2"),
+					resource.TestCheckResourceAttrSet(rName, "routes.0.id"),
+					resource.TestCheckResourceAttr(rName, "routes.0.status", "available"),
+				),
+			},
+			{
+				Config: testAccBlackholeRoutesV3_basic(name, bgpAsNum, `"10.20.0.0/16", "10.30.0.0/16", "10.40.0.0/16"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "destinations.#", "3"),
+					resource.TestCheckTypeSetElemAttr(rName, "destinations.*", "10.40.0.0/16"),
+					resource.TestCheckResourceAttr(rName, "routes.#", "3"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBlackholeRoutesV3Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.ErV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_er_blackhole_routes_v3" {
+			continue
+		}
+
+		resp, err := route.ListStatic(client, route.ListStaticOpts{RouteTableId: rs.Primary.ID})
+		if err != nil {
+			// route table is already deleted
+			continue
+		}
+		for _, r := range resp.Routes {
+			if r.IsBlackhole {
+				return fmt.Errorf("black hole route (%s) still exists", r.Destination)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccBlackholeRoutesV3_basic(name string, bgpAsNum int, destinations string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_er_instance_v3" "test" {
+  availability_zones = ["eu-de-01", "eu-de-02"]
+
+  name = "%[1]s"
+  asn  = %[2]d
+}
+
+resource "opentelekomcloud_er_route_table_v3" "test" {
+  instance_id = opentelekomcloud_er_instance_v3.test.id
+  name        = "%[1]s"
+}
+
+resource "opentelekomcloud_er_blackhole_routes_v3" "test" {
+  route_table_id = opentelekomcloud_er_route_table_v3.test.id
+  destinations   = [%[3]s]
+}
+`, name, bgpAsNum, destinations)
+}
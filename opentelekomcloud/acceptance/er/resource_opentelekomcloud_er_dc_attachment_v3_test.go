@@ -0,0 +1,53 @@
+package er
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+func TestAccDcAttachmentV3_basic(t *testing.T) {
+	var (
+		rName = "opentelekomcloud_er_dc_attachment_v3.test"
+		name  = fmt.Sprintf("er-acc-dc-api%s", acctest.RandString(5))
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			common.TestAccPreCheckErDcAttachment(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcAttachmentV3_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "instance_id", env.OS_ER_INSTANCE_ID),
+					resource.TestCheckResourceAttr(rName, "virtual_gateway_id", env.OS_ER_DC_VGW_ID),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "status", "available"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccVpcAttachmentImportStateFunc(rName),
+			},
+		},
+	})
+}
+
+func testAccDcAttachmentV3_basic(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_er_dc_attachment_v3" "test" {
+  instance_id        = "%s"
+  virtual_gateway_id = "%s"
+  name               = "%s"
+}
+`, env.OS_ER_INSTANCE_ID, env.OS_ER_DC_VGW_ID, name)
+}
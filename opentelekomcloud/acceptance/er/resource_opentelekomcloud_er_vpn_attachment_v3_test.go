@@ -0,0 +1,119 @@
+package er
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccVpnAttachmentV3_basic(t *testing.T) {
+	var (
+		rName      = "opentelekomcloud_er_vpn_attachment_v3.test"
+		name       = fmt.Sprintf("er-acc-vpn-api%s", acctest.RandString(5))
+		updateName = fmt.Sprintf("er-acc-vpn-api%s", acctest.RandString(5))
+		bgpAsNum   = acctest.RandIntRange(64512, 65534)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpnAttachmentV3_basic(name, bgpAsNum, name, "Create by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rName, "instance_id",
+						"opentelekomcloud_er_instance_v3.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "vpn_connection_id",
+						"opentelekomcloud_enterprise_vpn_connection_v5.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "Create by acc test"),
+					resource.TestCheckResourceAttr(rName, "status", "available"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+			{
+				Config: testAccVpnAttachmentV3_basic(name, bgpAsNum, updateName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "name", updateName),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccVpcAttachmentImportStateFunc(rName),
+			},
+		},
+	})
+}
+
+func testAccVpnAttachmentV3_base(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_vpc_v1" "test" {
+  name = "%[1]s"
+  cidr = "172.16.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "test" {
+  name       = "%[1]s"
+  vpc_id     = opentelekomcloud_vpc_v1.test.id
+  cidr       = "172.16.0.0/24"
+  gateway_ip = "172.16.0.1"
+}
+
+resource "opentelekomcloud_er_instance_v3" "test" {
+  availability_zones = ["eu-de-01", "eu-de-02"]
+
+  name = "%[1]s"
+  asn  = %[2]d
+}
+
+resource "opentelekomcloud_enterprise_vpn_gateway_v5" "test" {
+  name            = "%[1]s"
+  network_type    = "private"
+  attachment_type = "er"
+  er_id           = opentelekomcloud_er_instance_v3.test.id
+
+  availability_zones = ["eu-de-01", "eu-de-02"]
+
+  access_vpc_id    = opentelekomcloud_vpc_v1.test.id
+  access_subnet_id = opentelekomcloud_vpc_subnet_v1.test.id
+
+  access_private_ip_1 = "172.16.0.99"
+  access_private_ip_2 = "172.16.0.100"
+}
+
+resource "opentelekomcloud_enterprise_vpn_customer_gateway_v5" "test" {
+  name     = "%[1]s"
+  id_value = "10.10.1.2"
+}
+
+resource "opentelekomcloud_enterprise_vpn_connection_v5" "test" {
+  name                = "%[1]s"
+  gateway_id          = opentelekomcloud_enterprise_vpn_gateway_v5.test.id
+  gateway_ip          = opentelekomcloud_enterprise_vpn_gateway_v5.test.access_private_ip_1
+  customer_gateway_id = opentelekomcloud_enterprise_vpn_customer_gateway_v5.test.id
+  peer_subnets        = ["192.168.55.0/24"]
+  vpn_type            = "static"
+  psk                 = "Test@123"
+}
+`, name, bgpAsNum)
+}
+
+func testAccVpnAttachmentV3_basic(name string, bgpAsNum int, attachmentName, description string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "opentelekomcloud_er_vpn_attachment_v3" "test" {
+  instance_id       = opentelekomcloud_er_instance_v3.test.id
+  vpn_connection_id = opentelekomcloud_enterprise_vpn_connection_v5.test.id
+
+  name        = "%[2]s"
+  description = "%[3]s"
+}
+`, testAccVpnAttachmentV3_base(name, bgpAsNum), attachmentName, description)
+}
@@ -0,0 +1,61 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const dataSourceSnapshotName = "data.opentelekomcloud_evs_snapshot_v2.snapshot"
+
+func TestAccEvsSnapshotV2DataSource_basic(t *testing.T) {
+	dc := common.InitDataSourceCheck(dataSourceSnapshotName)
+	rName := fmt.Sprintf("evs-snap-%s", acctest.RandString(5))
+	t.Parallel()
+	quotas.BookMany(t, volumeQuotas(12))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvsSnapshotV2DataSourceBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceSnapshotName, "name", rName),
+					resource.TestCheckResourceAttr(dataSourceSnapshotName, "status", "available"),
+					resource.TestCheckResourceAttrPair(dataSourceSnapshotName, "id",
+						"opentelekomcloud_evs_snapshot_v2.snapshot_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEvsSnapshotV2DataSourceBasic(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_evs_volume_v3" "volume_1" {
+  name              = "%[1]s"
+  availability_zone = "%[2]s"
+  volume_type       = "SATA"
+  size              = 12
+}
+
+resource "opentelekomcloud_evs_snapshot_v2" "snapshot_1" {
+  volume_id = opentelekomcloud_evs_volume_v3.volume_1.id
+  name      = "%[1]s"
+}
+
+data "opentelekomcloud_evs_snapshot_v2" "snapshot" {
+  volume_id   = opentelekomcloud_evs_volume_v3.volume_1.id
+  most_recent = true
+
+  depends_on = [opentelekomcloud_evs_snapshot_v2.snapshot_1]
+}
+`, name, env.OS_AVAILABILITY_ZONE)
+}
@@ -0,0 +1,211 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/evs/v2/snapshots"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceSnapshotName = "opentelekomcloud_evs_snapshot_v2.snapshot_1"
+
+func TestAccEvsSnapshotV2_basic(t *testing.T) {
+	var snapshot snapshots.Snapshot
+	rName := fmt.Sprintf("evs-snap-%s", acctest.RandString(5))
+	t.Parallel()
+	quotas.BookMany(t, volumeQuotas(24))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckEvsSnapshotV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvsSnapshotV2Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvsSnapshotV2Exists(resourceSnapshotName, &snapshot),
+					resource.TestCheckResourceAttr(resourceSnapshotName, "name", rName),
+					resource.TestCheckResourceAttr(resourceSnapshotName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceSnapshotName, "size", "12"),
+					resource.TestCheckResourceAttr(resourceSnapshotName, "metadata.foo", "bar"),
+					resource.TestCheckResourceAttrPair(resourceSnapshotName, "volume_id",
+						"opentelekomcloud_evs_volume_v3.volume_1", "id"),
+					resource.TestCheckResourceAttrPair("opentelekomcloud_evs_volume_v3.clone", "snapshot_id",
+						resourceSnapshotName, "id"),
+				),
+			},
+			{
+				Config: testAccEvsSnapshotV2Update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvsSnapshotV2Exists(resourceSnapshotName, &snapshot),
+					resource.TestCheckResourceAttr(resourceSnapshotName, "name", rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceSnapshotName, "description", "updated description"),
+				),
+			},
+			{
+				ResourceName:            resourceSnapshotName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force", "metadata"},
+			},
+		},
+	})
+}
+
+func TestAccEvsSnapshotV2_rollback(t *testing.T) {
+	rName := fmt.Sprintf("evs-snap-%s", acctest.RandString(5))
+	t.Parallel()
+	quotas.BookMany(t, volumeQuotas(12))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckEvsSnapshotV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvsSnapshotV2Rollback(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("opentelekomcloud_evs_snapshot_rollback_v2.rollback", "volume_id",
+						"opentelekomcloud_evs_volume_v3.volume_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEvsSnapshotV2Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.BlockStorageV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud BlockStorageV2 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_evs_snapshot_v2" {
+			continue
+		}
+
+		_, err := snapshots.Get(client, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("EVS snapshot still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckEvsSnapshotV2Exists(n string, snapshot *snapshots.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.BlockStorageV2Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud BlockStorageV2 client: %s", err)
+		}
+
+		found, err := snapshots.Get(client, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("EVS snapshot not found")
+		}
+
+		*snapshot = *found
+
+		return nil
+	}
+}
+
+func testAccEvsSnapshotV2Basic(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_evs_volume_v3" "volume_1" {
+  name              = "%[1]s"
+  availability_zone = "%[2]s"
+  volume_type       = "SATA"
+  size              = 12
+}
+
+resource "opentelekomcloud_evs_snapshot_v2" "snapshot_1" {
+  volume_id   = opentelekomcloud_evs_volume_v3.volume_1.id
+  name        = "%[1]s"
+  description = "test snapshot"
+
+  metadata = {
+    foo = "bar"
+  }
+}
+
+resource "opentelekomcloud_evs_volume_v3" "clone" {
+  name              = "%[1]s-clone"
+  availability_zone = "%[2]s"
+  volume_type       = "SATA"
+  snapshot_id       = opentelekomcloud_evs_snapshot_v2.snapshot_1.id
+}
+`, name, env.OS_AVAILABILITY_ZONE)
+}
+
+func testAccEvsSnapshotV2Update(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_evs_volume_v3" "volume_1" {
+  name              = "%[1]s"
+  availability_zone = "%[2]s"
+  volume_type       = "SATA"
+  size              = 12
+}
+
+resource "opentelekomcloud_evs_snapshot_v2" "snapshot_1" {
+  volume_id   = opentelekomcloud_evs_volume_v3.volume_1.id
+  name        = "%[1]s-updated"
+  description = "updated description"
+
+  metadata = {
+    foo = "bar"
+  }
+}
+
+resource "opentelekomcloud_evs_volume_v3" "clone" {
+  name              = "%[1]s-clone"
+  availability_zone = "%[2]s"
+  volume_type       = "SATA"
+  snapshot_id       = opentelekomcloud_evs_snapshot_v2.snapshot_1.id
+}
+`, name, env.OS_AVAILABILITY_ZONE)
+}
+
+func testAccEvsSnapshotV2Rollback(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_evs_volume_v3" "volume_1" {
+  name              = "%[1]s"
+  availability_zone = "%[2]s"
+  volume_type       = "SATA"
+  size              = 12
+}
+
+resource "opentelekomcloud_evs_snapshot_v2" "snapshot_1" {
+  volume_id = opentelekomcloud_evs_volume_v3.volume_1.id
+  name      = "%[1]s"
+}
+
+resource "opentelekomcloud_evs_snapshot_rollback_v2" "rollback" {
+  snapshot_id = opentelekomcloud_evs_snapshot_v2.snapshot_1.id
+}
+`, name, env.OS_AVAILABILITY_ZONE)
+}
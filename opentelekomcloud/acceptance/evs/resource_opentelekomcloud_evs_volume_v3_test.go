@@ -159,6 +159,36 @@ func TestAccEvsStorageV3Volume_resize(t *testing.T) {
 	})
 }
 
+func TestAccEvsStorageV3Volume_retype(t *testing.T) {
+	var volume volumes.Volume
+	var volumeRetyped volumes.Volume
+	t.Parallel()
+	quotas.BookMany(t, volumeQuotas(20))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckEvsStorageV3VolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvsStorageV3VolumeBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvsStorageV3VolumeExists(resourceVolumeV3Name, &volume),
+					resource.TestCheckResourceAttr(resourceVolumeV3Name, "volume_type", "SATA"),
+				),
+			},
+			{
+				Config: testAccEvsStorageV3VolumeRetype,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvsStorageV3VolumePersists(resourceVolumeV3Name, &volumeRetyped, &volume),
+					resource.TestCheckResourceAttr(resourceVolumeV3Name, "volume_type", "SSD"),
+					resource.TestCheckResourceAttr(resourceVolumeV3Name, "size", "20"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEvsStorageV3VolumeDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	client, err := config.BlockStorageV3Client(env.OS_REGION_NAME)
@@ -334,5 +364,14 @@ resource "opentelekomcloud_evs_volume_v3" "volume_1" {
   volume_type       = "SATA"
   size              = 20
 }
+`, env.OS_AVAILABILITY_ZONE)
+	testAccEvsStorageV3VolumeRetype = fmt.Sprintf(`
+resource "opentelekomcloud_evs_volume_v3" "volume_1" {
+  name              = "volume_1"
+  description       = "first test volume"
+  availability_zone = "%s"
+  volume_type       = "SSD"
+  size              = 20
+}
 `, env.OS_AVAILABILITY_ZONE)
 )
@@ -0,0 +1,229 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/fwaas_v2/firewall_groups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/fwaas_v2/policies"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/fwaas_v2/rules"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceNetworkACLName = "opentelekomcloud_network_acl.acl_1"
+
+func TestAccNetworkACL_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNetworkACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkACLBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNetworkACLName, "name", "acl_1"),
+					resource.TestCheckResourceAttr(resourceNetworkACLName, "subnets.#", "1"),
+					resource.TestCheckResourceAttr(resourceNetworkACLName, "ports.#", "1"),
+					resource.TestCheckResourceAttr(resourceNetworkACLName, "inbound_rules.#", "3"),
+					resource.TestCheckResourceAttr(resourceNetworkACLName, "outbound_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceNetworkACLName, "status", "ACTIVE"),
+					testAccCheckNetworkACLRuleOrder(resourceNetworkACLName, "inbound",
+						[]string{"ssh", "http", "https"}),
+				),
+			},
+			{
+				Config: testAccNetworkACLReorder,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNetworkACLName, "name", "acl_1_updated"),
+					resource.TestCheckResourceAttr(resourceNetworkACLName, "inbound_rules.#", "4"),
+					resource.TestCheckResourceAttr(resourceNetworkACLName, "inbound_rules.0.name", "https"),
+					resource.TestCheckResourceAttr(resourceNetworkACLName, "inbound_rules.3.name", "deny_all"),
+					resource.TestCheckResourceAttr(resourceNetworkACLName, "outbound_rules.#", "0"),
+					testAccCheckNetworkACLRuleOrder(resourceNetworkACLName, "inbound",
+						[]string{"https", "dns", "ssh", "deny_all"}),
+				),
+			},
+			{
+				Config: testAccNetworkACLNoSubnets,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNetworkACLName, "subnets.#", "0"),
+					resource.TestCheckResourceAttr(resourceNetworkACLName, "status", "INACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceNetworkACLName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkACLDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	networkingClient, err := config.NetworkingV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud networking client: %s", err)
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_network_acl" {
+			continue
+		}
+
+		_, err = firewall_groups.Get(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("network ACL (%s) still exists", rs.Primary.ID)
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+
+		for _, key := range []string{"inbound_policy_id", "outbound_policy_id"} {
+			_, err = policies.Get(networkingClient, rs.Primary.Attributes[key]).Extract()
+			if err == nil {
+				return fmt.Errorf("network ACL policy (%s) still exists", rs.Primary.Attributes[key])
+			}
+		}
+	}
+	return nil
+}
+
+// testAccCheckNetworkACLRuleOrder checks the order of the rules in the policy using the rule names
+func testAccCheckNetworkACLRuleOrder(n, direction string, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		networkingClient, err := config.NetworkingV2Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		policy, err := policies.Get(networkingClient, rs.Primary.Attributes[direction+"_policy_id"]).Extract()
+		if err != nil {
+			return err
+		}
+		if len(policy.Rules) != len(expected) {
+			return fmt.Errorf("expected %d %s rules, got %d", len(expected), direction, len(policy.Rules))
+		}
+		for i, ruleID := range policy.Rules {
+			rule, err := rules.Get(networkingClient, ruleID).Extract()
+			if err != nil {
+				return err
+			}
+			if rule.Name != expected[i] {
+				return fmt.Errorf("expected %s rule #%d to be %q, got %q", direction, i, expected[i], rule.Name)
+			}
+		}
+		return nil
+	}
+}
+
+var testAccNetworkACLBasic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_network_acl" "acl_1" {
+  name    = "acl_1"
+  subnets = [data.opentelekomcloud_vpc_subnet_v1.shared_subnet.id]
+
+  inbound_rules {
+    name             = "ssh"
+    action           = "allow"
+    protocol         = "tcp"
+    destination_port = "22"
+  }
+  inbound_rules {
+    name             = "http"
+    action           = "allow"
+    protocol         = "tcp"
+    destination_port = "80"
+  }
+  inbound_rules {
+    name             = "https"
+    action           = "allow"
+    protocol         = "tcp"
+    destination_port = "443"
+  }
+
+  outbound_rules {
+    name                   = "all"
+    action                 = "allow"
+    protocol               = "any"
+    destination_ip_address = "0.0.0.0/0"
+  }
+}
+`, common.DataSourceSubnet)
+
+var testAccNetworkACLReorder = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_network_acl" "acl_1" {
+  name        = "acl_1_updated"
+  description = "network ACL with reordered rules"
+  subnets     = [data.opentelekomcloud_vpc_subnet_v1.shared_subnet.id]
+
+  inbound_rules {
+    name             = "https"
+    action           = "allow"
+    protocol         = "tcp"
+    destination_port = "443"
+  }
+  inbound_rules {
+    name             = "dns"
+    action           = "allow"
+    protocol         = "udp"
+    destination_port = "53"
+  }
+  inbound_rules {
+    name             = "ssh"
+    action           = "allow"
+    protocol         = "tcp"
+    destination_port = "22"
+  }
+  inbound_rules {
+    name     = "deny_all"
+    action   = "deny"
+    protocol = "any"
+  }
+}
+`, common.DataSourceSubnet)
+
+var testAccNetworkACLNoSubnets = `
+resource "opentelekomcloud_network_acl" "acl_1" {
+  name        = "acl_1_updated"
+  description = "network ACL with reordered rules"
+
+  inbound_rules {
+    name             = "https"
+    action           = "allow"
+    protocol         = "tcp"
+    destination_port = "443"
+  }
+  inbound_rules {
+    name             = "dns"
+    action           = "allow"
+    protocol         = "udp"
+    destination_port = "53"
+  }
+  inbound_rules {
+    name             = "ssh"
+    action           = "allow"
+    protocol         = "tcp"
+    destination_port = "22"
+  }
+  inbound_rules {
+    name     = "deny_all"
+    action   = "deny"
+    protocol = "any"
+  }
+}
+`
@@ -54,6 +54,25 @@ func TestAccNatDnatRule_withPort(t *testing.T) {
 	})
 }
 
+func TestAccNatDnatRule_portRange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNatDnatDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatDnatRulePortRange,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNatDnatExists(resourceDnatRuleName),
+					resource.TestCheckResourceAttr(resourceDnatRuleName, "internal_service_port_range", "8000-8009"),
+					resource.TestCheckResourceAttr(resourceDnatRuleName, "external_service_port_range", "9000-9009"),
+					resource.TestCheckResourceAttr(resourceDnatRuleName, "protocol", "tcp"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNatDnat_importBasic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { common.TestAccPreCheck(t) },
@@ -206,3 +225,25 @@ resource "opentelekomcloud_nat_dnat_rule_v2" "dnat" {
   external_service_port = 242
 }
 `, common.DataSourceImage, common.DataSourceSubnet, env.OS_AVAILABILITY_ZONE)
+
+var testAccNatDnatRulePortRange = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_networking_floatingip_v2" "fip_1" {}
+
+resource "opentelekomcloud_nat_gateway_v2" "nat_gw" {
+  name                = "dnat_rule_range_gw"
+  spec                = "1"
+  internal_network_id = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.network_id
+  router_id           = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.vpc_id
+}
+
+resource "opentelekomcloud_nat_dnat_rule_v2" "dnat" {
+  floating_ip_id              = opentelekomcloud_networking_floatingip_v2.fip_1.id
+  nat_gateway_id              = opentelekomcloud_nat_gateway_v2.nat_gw.id
+  private_ip                  = cidrhost(data.opentelekomcloud_vpc_subnet_v1.shared_subnet.cidr, 10)
+  protocol                    = "tcp"
+  internal_service_port_range = "8000-8009"
+  external_service_port_range = "9000-9009"
+}
+`, common.DataSourceSubnet)
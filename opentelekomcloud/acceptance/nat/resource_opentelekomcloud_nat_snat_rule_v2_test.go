@@ -37,6 +37,34 @@ func TestAccNatSnatRule_basic(t *testing.T) {
 	})
 }
 
+func TestAccNatSnatRule_multipleFloatingIPs(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNatV2SnatRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatV2SnatRuleMultipleIPs(`[
+    opentelekomcloud_networking_floatingip_v2.fip_1.id,
+    opentelekomcloud_networking_floatingip_v2.fip_2.id,
+  ]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNatV2SnatRuleExists(resourceSnatRuleName),
+					resource.TestCheckResourceAttrSet(resourceSnatRuleName, "floating_ip_address"),
+				),
+			},
+			{
+				// changed order of IDs shouldn't cause re-creation
+				Config: testAccNatV2SnatRuleMultipleIPs(`[
+    opentelekomcloud_networking_floatingip_v2.fip_2.id,
+    opentelekomcloud_networking_floatingip_v2.fip_1.id,
+  ]`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckNatV2SnatRuleDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	client, err := config.NatV2Client(env.OS_REGION_NAME)
@@ -108,3 +136,27 @@ resource "opentelekomcloud_nat_snat_rule_v2" "snat_1" {
   source_type    = 0
 }
 `, common.DataSourceSubnet)
+
+func testAccNatV2SnatRuleMultipleIPs(ids string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_networking_floatingip_v2" "fip_1" {}
+
+resource "opentelekomcloud_networking_floatingip_v2" "fip_2" {}
+
+resource "opentelekomcloud_nat_gateway_v2" "nat_1" {
+  name                = "nat_multi_ip"
+  spec                = "1"
+  internal_network_id = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.network_id
+  router_id           = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.vpc_id
+}
+
+resource "opentelekomcloud_nat_snat_rule_v2" "snat_1" {
+  nat_gateway_id = opentelekomcloud_nat_gateway_v2.nat_1.id
+  floating_ip_id = join(",", %s)
+  cidr           = "192.168.0.0/24"
+  source_type    = 0
+}
+`, common.DataSourceSubnet, ids)
+}
@@ -3,6 +3,7 @@ package acceptance
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -48,6 +49,27 @@ func TestAccObsBucketReplication_basic(t *testing.T) {
 	})
 }
 
+func TestAccObsBucketReplication_validation(t *testing.T) {
+	destBucket := os.Getenv("OS_DESTINATION_BUCKET")
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObsBucketReplicationOverlap(rInt, destBucket),
+				ExpectError: regexp.MustCompile(`replication rule prefixes "log" and "logs/" overlap`),
+			},
+			{
+				Config:      testAccObsBucketReplicationMissingAgency(rInt, destBucket),
+				ExpectError: regexp.MustCompile(`IAM agency test-obs-agency-missing doesn't exist`),
+			},
+		},
+	})
+}
+
 func TestAccOBSReplication_importBasic(t *testing.T) {
 	destBucket := os.Getenv("OS_DESTINATION_BUCKET")
 	rInt := acctest.RandInt()
@@ -141,3 +163,47 @@ resource "opentelekomcloud_obs_bucket_replication" "test" {
 }
 `, randInt, env.OS_TENANT_NAME, destBucket)
 }
+
+func testAccObsBucketReplicationOverlap(randInt int, destBucket string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket        = "tf-test-bucket-%d"
+  storage_class = "STANDARD"
+  acl           = "private"
+}
+
+resource "opentelekomcloud_obs_bucket_replication" "test" {
+  bucket             = opentelekomcloud_obs_bucket.bucket.bucket
+  destination_bucket = "%s"
+  agency             = "test-obs-agency"
+
+  rule {
+    prefix = "log"
+  }
+
+  rule {
+    prefix = "logs/"
+  }
+}
+`, randInt, destBucket)
+}
+
+func testAccObsBucketReplicationMissingAgency(randInt int, destBucket string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket        = "tf-test-bucket-%d"
+  storage_class = "STANDARD"
+  acl           = "private"
+}
+
+resource "opentelekomcloud_obs_bucket_replication" "test" {
+  bucket             = opentelekomcloud_obs_bucket.bucket.bucket
+  destination_bucket = "%s"
+  agency             = "test-obs-agency-missing"
+
+  rule {
+    prefix = "log"
+  }
+}
+`, randInt, destBucket)
+}
@@ -0,0 +1,43 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccSFSTurboSharesV1DataSource_basic(t *testing.T) {
+	name := tools.RandomString("turbo-", 5)
+	dsName := "data.opentelekomcloud_sfs_turbo_shares_v1.shares"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSTurboShareV1Basic(name),
+			},
+			{
+				Config: testAccSFSTurboSharesV1DataSourceBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "shares.#", "1"),
+					resource.TestCheckResourceAttr(dsName, "shares.0.name", name),
+					resource.TestCheckResourceAttr(dsName, "shares.0.size", "500"),
+					resource.TestCheckResourceAttrSet(dsName, "shares.0.export_location"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSFSTurboSharesV1DataSourceBasic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_sfs_turbo_shares_v1" "shares" {
+  name = opentelekomcloud_sfs_turbo_share_v1.sfs-turbo.name
+}
+`, testAccSFSTurboShareV1Basic(name))
+}
@@ -0,0 +1,68 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const resourceTurboOBSTargetName = "opentelekomcloud_sfs_turbo_obs_target_v1.target"
+
+func TestAccSFSTurboOBSTargetV1_basic(t *testing.T) {
+	name := tools.RandomString("turbo-", 5)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckSFSTurboShareV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSTurboOBSTargetV1Basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTurboOBSTargetName, "file_system_path", "obs-data"),
+					resource.TestCheckResourceAttr(resourceTurboOBSTargetName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceTurboOBSTargetName, "obs.0.bucket", name),
+				),
+			},
+			{
+				ResourceName:      resourceTurboOBSTargetName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccSFSTurboOBSTargetV1ImportStateIDFunc(),
+			},
+		},
+	})
+}
+
+func testAccSFSTurboOBSTargetV1ImportStateIDFunc() resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		target := s.RootModule().Resources[resourceTurboOBSTargetName].Primary
+		return fmt.Sprintf("%s/%s", target.Attributes["share_id"], target.ID), nil
+	}
+}
+
+func testAccSFSTurboOBSTargetV1Basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "%s"
+  acl    = "private"
+}
+
+resource "opentelekomcloud_sfs_turbo_obs_target_v1" "target" {
+  share_id         = opentelekomcloud_sfs_turbo_share_v1.sfs-turbo.id
+  file_system_path = "obs-data"
+
+  obs {
+    bucket   = opentelekomcloud_obs_bucket.bucket.bucket
+    endpoint = "obs.%s.otc.t-systems.com"
+  }
+}
+`, testAccSFSTurboShareV1Basic(name), name, env.OS_REGION_NAME)
+}
@@ -0,0 +1,89 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+)
+
+func TestAccVpcEipsV1DataSource_basic(t *testing.T) {
+	dataSourceNameByTags := "data.opentelekomcloud_vpc_eips_v1.by_tags"
+	dataSourceNameByStatus := "data.opentelekomcloud_vpc_eips_v1.by_status"
+
+	t.Parallel()
+	quotas.BookMany(t, quotas.MultipleQuotas{{Q: quotas.FloatingIP, Count: 2}})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVpcEipsV1Init,
+			},
+			{
+				Config: testAccDataSourceVpcEipsV1Config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceNameByTags, "eips.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceNameByTags, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceNameByTags, "eips.0.type", "5_bgp"),
+					resource.TestCheckResourceAttr(dataSourceNameByTags, "eips.0.status", "DOWN"),
+					resource.TestCheckResourceAttr(dataSourceNameByTags, "eips.0.tags.group", "eips-ds"),
+					resource.TestCheckResourceAttr(dataSourceNameByStatus, "eips.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceNameByStatus, "eips.0.name", "my_eip_2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceVpcEipsV1Init = `
+resource "opentelekomcloud_vpc_eip_v1" "eip_1" {
+  publicip {
+    type = "5_bgp"
+    name = "my_eip_1"
+  }
+  bandwidth {
+    name        = "acc-band-1"
+    size        = 8
+    share_type  = "PER"
+    charge_mode = "traffic"
+  }
+  tags = {
+    group = "eips-ds"
+  }
+}
+
+resource "opentelekomcloud_vpc_eip_v1" "eip_2" {
+  publicip {
+    type = "5_bgp"
+    name = "my_eip_2"
+  }
+  bandwidth {
+    name        = "acc-band-2"
+    size        = 8
+    share_type  = "PER"
+    charge_mode = "traffic"
+  }
+  tags = {
+    group = "eips-ds"
+    index = "second"
+  }
+}
+`
+
+const testAccDataSourceVpcEipsV1Config = testAccDataSourceVpcEipsV1Init + `
+data "opentelekomcloud_vpc_eips_v1" "by_tags" {
+  tags = {
+    group = "eips-ds"
+  }
+}
+
+data "opentelekomcloud_vpc_eips_v1" "by_status" {
+  status = "DOWN"
+  tags = {
+    index = "second"
+  }
+}
+`
@@ -0,0 +1,75 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+)
+
+func TestAccVpcFlowLogRecordsV1DataSource_basic(t *testing.T) {
+	dataSourceByFlowLog := "data.opentelekomcloud_vpc_flow_log_records_v1.by_flow_log"
+	dataSourceByTopic := "data.opentelekomcloud_vpc_flow_log_records_v1.by_topic"
+
+	t.Parallel()
+	quotas.BookOne(t, quotas.Router)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVpcFlowLogRecordsV1Config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceByFlowLog, "log_group_id",
+						"opentelekomcloud_logtank_group_v2.log_group1", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceByFlowLog, "log_topic_id",
+						"opentelekomcloud_logtank_topic_v2.log_topic1", "id"),
+					resource.TestCheckResourceAttrSet(dataSourceByFlowLog, "records.#"),
+					resource.TestCheckResourceAttrSet(dataSourceByTopic, "records.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceVpcFlowLogRecordsV1Config = `
+resource "opentelekomcloud_logtank_group_v2" "log_group1" {
+  group_name = "vpc_group_records"
+}
+
+resource "opentelekomcloud_logtank_topic_v2" "log_topic1" {
+  group_id   = opentelekomcloud_logtank_group_v2.log_group1.id
+  topic_name = "vpc_topic_records"
+}
+
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc_test_fl_records"
+  cidr = "172.16.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_flow_log_v1" "flow_log" {
+  name          = "vpc_flow_log_records"
+  resource_type = "vpc"
+  resource_id   = opentelekomcloud_vpc_v1.vpc_1.id
+  traffic_type  = "all"
+  log_group_id  = opentelekomcloud_logtank_group_v2.log_group1.id
+  log_topic_id  = opentelekomcloud_logtank_topic_v2.log_topic1.id
+}
+
+data "opentelekomcloud_vpc_flow_log_records_v1" "by_flow_log" {
+  flow_log_id = opentelekomcloud_vpc_flow_log_v1.flow_log.id
+  time_range  = "15m"
+}
+
+data "opentelekomcloud_vpc_flow_log_records_v1" "by_topic" {
+  log_group_id     = opentelekomcloud_logtank_group_v2.log_group1.id
+  log_topic_id     = opentelekomcloud_logtank_topic_v2.log_topic1.id
+  destination_port = 443
+  protocol         = "tcp"
+  action           = "ACCEPT"
+
+  depends_on = [opentelekomcloud_vpc_flow_log_v1.flow_log]
+}
+`
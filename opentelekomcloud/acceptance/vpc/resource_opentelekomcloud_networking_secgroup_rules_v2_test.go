@@ -0,0 +1,174 @@
+package acceptance
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceNwSGRulesName = "opentelekomcloud_networking_secgroup_rules_v2.rules"
+
+func TestAccNetworkingV2SecGroupRules_basic(t *testing.T) {
+	t.Parallel()
+	quotas.BookOne(t, quotas.SecurityGroup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNetworkingV2SecGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroupRulesBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNwSGRulesName, "rule.#", "2"),
+					// 2 ports x 2 CIDRs + 1 egress
+					testAccCheckNetworkingV2SecGroupRulesCount(resourceNwSGRulesName, 5),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SecGroupRulesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNwSGRulesName, "rule.#", "1"),
+					testAccCheckNetworkingV2SecGroupRulesCount(resourceNwSGRulesName, 1),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SecGroupRulesICMP,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNwSGRulesName, "rule.#", "2"),
+					// 1 tcp + echo reply and echo request
+					testAccCheckNetworkingV2SecGroupRulesCount(resourceNwSGRulesName, 3),
+				),
+			},
+			{
+				Config:      testAccNetworkingV2SecGroupRulesInvalidPort,
+				ExpectError: regexp.MustCompile("expected values between 1 and 65535"),
+			},
+			{
+				ResourceName:      resourceNwSGRulesName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2SecGroupRulesCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.NetworkingV2Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+		}
+
+		pages, err := rules.List(client, rules.ListOpts{SecGroupID: rs.Primary.ID}).AllPages()
+		if err != nil {
+			return err
+		}
+		found, err := rules.ExtractRules(pages)
+		if err != nil {
+			return err
+		}
+		if len(found) != expected {
+			return fmt.Errorf("expected %d security group rules, got %d", expected, len(found))
+		}
+		return nil
+	}
+}
+
+const testAccNetworkingV2SecGroupRulesBasic = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name        = "secgroup_rules_1"
+  description = "terraform security group rules acceptance test"
+}
+
+resource "opentelekomcloud_networking_secgroup_rules_v2" "rules" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+
+  rule {
+    direction          = "ingress"
+    protocol           = "tcp"
+    ports              = "22,8000-8080"
+    remote_ip_prefixes = ["10.0.0.0/24", "192.168.0.0/24"]
+    description        = "ssh and http"
+  }
+
+  rule {
+    direction = "egress"
+  }
+}
+`
+
+const testAccNetworkingV2SecGroupRulesUpdate = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name        = "secgroup_rules_1"
+  description = "terraform security group rules acceptance test"
+}
+
+resource "opentelekomcloud_networking_secgroup_rules_v2" "rules" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+
+  rule {
+    direction          = "ingress"
+    protocol           = "tcp"
+    ports              = "443"
+    remote_ip_prefixes = ["10.0.0.0/24"]
+  }
+}
+`
+
+const testAccNetworkingV2SecGroupRulesICMP = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name        = "secgroup_rules_1"
+  description = "terraform security group rules acceptance test"
+}
+
+resource "opentelekomcloud_networking_secgroup_rules_v2" "rules" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+
+  rule {
+    direction          = "ingress"
+    protocol           = "tcp"
+    ports              = "443"
+    remote_ip_prefixes = ["10.0.0.0/24"]
+  }
+
+  rule {
+    direction          = "ingress"
+    protocol           = "icmp"
+    ports              = "0-0,8-0"
+    remote_ip_prefixes = ["10.0.0.0/24"]
+  }
+}
+`
+
+const testAccNetworkingV2SecGroupRulesInvalidPort = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name        = "secgroup_rules_1"
+  description = "terraform security group rules acceptance test"
+}
+
+resource "opentelekomcloud_networking_secgroup_rules_v2" "rules" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+
+  rule {
+    direction = "ingress"
+    protocol  = "tcp"
+    ports     = "0"
+  }
+}
+`
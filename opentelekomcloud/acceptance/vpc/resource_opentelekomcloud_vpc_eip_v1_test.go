@@ -2,6 +2,7 @@ package acceptance
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -76,6 +77,81 @@ func TestAccVpcV1EIP_UnAssing(t *testing.T) {
 	})
 }
 
+func TestAccVpcV1EIP_sharedBandwidth(t *testing.T) {
+	var eip eips.PublicIp
+	t.Parallel()
+	quotas.BookOne(t, quotas.FloatingIP)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckVpcV1EIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcV1EIPSharedBandwidth("PER", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1EIPExists(resourceVPCEIPName, &eip),
+					resource.TestCheckResourceAttr(resourceVPCEIPName, "bandwidth.0.share_type", "PER"),
+				),
+			},
+			{
+				Config: testAccVpcV1EIPSharedBandwidth("WHOLE", "opentelekomcloud_vpc_bandwidth_v2.bw_1.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1EIPExists(resourceVPCEIPName, &eip),
+					resource.TestCheckResourceAttrPtr(resourceVPCEIPName, "id", &eip.ID),
+					resource.TestCheckResourceAttr(resourceVPCEIPName, "bandwidth.0.share_type", "WHOLE"),
+					resource.TestCheckResourceAttrPair(resourceVPCEIPName, "bandwidth.0.id",
+						"opentelekomcloud_vpc_bandwidth_v2.bw_1", "id"),
+				),
+			},
+			{
+				Config: testAccVpcV1EIPSharedBandwidth("WHOLE", "opentelekomcloud_vpc_bandwidth_v2.bw_2.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1EIPExists(resourceVPCEIPName, &eip),
+					resource.TestCheckResourceAttrPtr(resourceVPCEIPName, "id", &eip.ID),
+					resource.TestCheckResourceAttrPair(resourceVPCEIPName, "bandwidth.0.id",
+						"opentelekomcloud_vpc_bandwidth_v2.bw_2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVpcV1EIP_ipv6(t *testing.T) {
+	var eip eips.PublicIp
+	t.Parallel()
+	quotas.BookOne(t, quotas.FloatingIP)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckVpcV1EIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcV1EIPIpv6,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1EIPExists(resourceVPCEIPName, &eip),
+					resource.TestCheckResourceAttr(resourceVPCEIPName, "publicip.0.ip_version", "6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVpcV1EIP_dedicatedBandwidthRequired(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVpcV1EIPDedicatedNoSize,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`bandwidth.0.size is required for the dedicated \(PER\) bandwidth`),
+			},
+		},
+	})
+}
+
 func TestAccVpcV1EIP_timeout(t *testing.T) {
 	var eip eips.PublicIp
 	t.Parallel()
@@ -262,3 +338,64 @@ resource "opentelekomcloud_vpc_eip_v1" "eip_1" {
 
 }
 `, common.DataSourceSubnet)
+
+func testAccVpcV1EIPSharedBandwidth(shareType, bandwidthID string) string {
+	bandwidth := `
+    name        = "acc-band"
+    size        = 8
+    share_type  = "PER"
+    charge_mode = "traffic"`
+	if shareType == "WHOLE" {
+		bandwidth = fmt.Sprintf(`
+    id         = %s
+    share_type = "WHOLE"`, bandwidthID)
+	}
+
+	return fmt.Sprintf(`
+resource "opentelekomcloud_vpc_bandwidth_v2" "bw_1" {
+  name = "acc-shared-band-1"
+  size = 10
+}
+
+resource "opentelekomcloud_vpc_bandwidth_v2" "bw_2" {
+  name = "acc-shared-band-2"
+  size = 20
+}
+
+resource "opentelekomcloud_vpc_eip_v1" "eip_1" {
+  publicip {
+    type = "5_bgp"
+    name = "my_ip"
+  }
+  bandwidth {%s
+  }
+}
+`, bandwidth)
+}
+
+const testAccVpcV1EIPIpv6 = `
+resource "opentelekomcloud_vpc_eip_v1" "eip_1" {
+  publicip {
+    type       = "5_bgp"
+    ip_version = 6
+  }
+  bandwidth {
+    name        = "acc-band-v6"
+    size        = 8
+    share_type  = "PER"
+    charge_mode = "traffic"
+  }
+}
+`
+
+const testAccVpcV1EIPDedicatedNoSize = `
+resource "opentelekomcloud_vpc_eip_v1" "eip_1" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    name       = "acc-band"
+    share_type = "PER"
+  }
+}
+`
@@ -83,6 +83,27 @@ func TestAccVpcRouteTableV1_multipleRoutes(t *testing.T) {
 	})
 }
 
+func TestAccVpcRouteTableV1_ipv6Route(t *testing.T) {
+	var rtb routetables.RouteTable
+	rtbName := tools.RandomString("rtb-", 5)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckRouteTableV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcRouteTable_ipv6(rtbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableV1Exists(resourceVPCRouteTableName, &rtb),
+					resource.TestCheckResourceAttr(resourceVPCRouteTableName, "route.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceVPCRouteTableName, "route.*.destination",
+						"opentelekomcloud_vpc_subnet_v1.subnet_2-1", "cidr_ipv6"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRouteTableV1Destroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	client, err := config.NetworkingV1Client(env.OS_REGION_NAME)
@@ -238,6 +259,59 @@ resource "opentelekomcloud_vpc_route_table_v1" "table_1" {
 `, testAccVpcRouteTable_network(name), name)
 }
 
+func testAccVpcRouteTable_ipv6(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "%[1]s-1"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_1-1" {
+  name        = "%[1]s-1-1"
+  cidr        = "192.168.0.0/24"
+  gateway_ip  = "192.168.0.1"
+  vpc_id      = opentelekomcloud_vpc_v1.vpc_1.id
+  ipv6_enable = true
+}
+
+resource "opentelekomcloud_vpc_v1" "vpc_2" {
+  name = "%[1]s-2"
+  cidr = "172.16.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_2-1" {
+  name        = "%[1]s-2-1"
+  cidr        = "172.16.10.0/24"
+  gateway_ip  = "172.16.10.1"
+  vpc_id      = opentelekomcloud_vpc_v1.vpc_2.id
+  ipv6_enable = true
+}
+
+resource "opentelekomcloud_vpc_peering_connection_v2" "peering" {
+  name        = "%[1]s"
+  vpc_id      = opentelekomcloud_vpc_v1.vpc_1.id
+  peer_vpc_id = opentelekomcloud_vpc_v1.vpc_2.id
+}
+
+resource "opentelekomcloud_vpc_route_table_v1" "table_1" {
+  name        = "%[1]s"
+  vpc_id      = opentelekomcloud_vpc_v1.vpc_1.id
+  description = "created by terraform with IPv6 route"
+
+  subnets = [
+    opentelekomcloud_vpc_subnet_v1.subnet_1-1.id,
+  ]
+
+  route {
+    destination = opentelekomcloud_vpc_subnet_v1.subnet_2-1.cidr_ipv6
+    type        = "peering"
+    nexthop     = opentelekomcloud_vpc_peering_connection_v2.peering.id
+    description = "peering IPv6 rule"
+  }
+}
+`, name)
+}
+
 func testAccVpcRouteTable_multiRoutes(name string) string {
 	return fmt.Sprintf(`
 %s
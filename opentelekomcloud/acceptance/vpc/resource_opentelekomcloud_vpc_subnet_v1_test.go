@@ -110,11 +110,23 @@ func TestAccVpcSubnetV1Ipv6(t *testing.T) {
 		ProviderFactories: common.TestAccProviderFactories,
 		CheckDestroy:      testAccCheckVpcSubnetV1Destroy,
 		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcSubnetV1Ipv6Disabled,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcSubnetV1Exists(resourceVPCSubnetName, &subnet),
+					resource.TestCheckResourceAttr(resourceVPCSubnetName, "ipv6_enable", "false"),
+					resource.TestCheckResourceAttr(resourceVPCSubnetName, "cidr_ipv6", ""),
+				),
+			},
 			{
 				Config: testAccVpcSubnetV1Ipv6,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVpcSubnetV1Exists(resourceVPCSubnetName, &subnet),
+					resource.TestCheckResourceAttrPtr(resourceVPCSubnetName, "id", &subnet.ID),
 					resource.TestCheckResourceAttr(resourceVPCSubnetName, "ipv6_enable", "true"),
+					resource.TestCheckResourceAttrSet(resourceVPCSubnetName, "cidr_ipv6"),
+					resource.TestCheckResourceAttrSet(resourceVPCSubnetName, "gateway_ipv6"),
+					resource.TestCheckResourceAttrSet(resourceVPCSubnetName, "subnet_id_ipv6"),
 				),
 			},
 		},
@@ -318,6 +330,27 @@ resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
 )
 
 const (
+	testAccVpcSubnetV1Ipv6Disabled = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc_test_ipv6"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
+  name              = "subnet_test_ipv6"
+  description       = "some description"
+  cidr              = "192.168.0.0/16"
+  gateway_ip        = "192.168.0.1"
+  vpc_id            = opentelekomcloud_vpc_v1.vpc_1.id
+  availability_zone = "eu-de-02"
+  ntp_addresses     = "10.100.0.33,10.100.0.34"
+
+  tags = {
+    foo = "bar"
+    key = "value"
+  }
+}
+`
 	testAccVpcSubnetV1Ipv6 = `
 resource "opentelekomcloud_vpc_v1" "vpc_1" {
   name = "vpc_test_ipv6"
@@ -89,6 +89,53 @@ func TestAccVpcV1_secondaryCidr(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceVPCName, "tags.key", "value_update"),
 				),
 			},
+			{
+				Config: testAccVpcV3MigrateCidr,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1Exists(resourceVPCName, &vpc),
+					resource.TestCheckResourceAttrPtr(resourceVPCName, "id", &vpc.ID),
+					resource.TestCheckResourceAttr(resourceVPCName, "secondary_cidrs.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceVPCName, "secondary_cidrs.*", "23.8.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVpcV1_secondaryCidrs(t *testing.T) {
+	var vpc vpcs.Vpc
+	t.Parallel()
+	quotas.BookOne(t, quotas.Router)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckVpcV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcV1SecondaryCidrs,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1Exists(resourceVPCName, &vpc),
+					resource.TestCheckResourceAttr(resourceVPCName, "secondary_cidrs.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceVPCName, "secondary_cidrs.*", "23.9.0.0/16"),
+					resource.TestCheckTypeSetElemAttr(resourceVPCName, "secondary_cidrs.*", "23.10.0.0/16"),
+				),
+			},
+			{
+				Config: testAccVpcV1SecondaryCidrsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1Exists(resourceVPCName, &vpc),
+					resource.TestCheckResourceAttrPtr(resourceVPCName, "id", &vpc.ID),
+					resource.TestCheckResourceAttr(resourceVPCName, "secondary_cidrs.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceVPCName, "secondary_cidrs.*", "23.10.0.0/16"),
+					resource.TestCheckTypeSetElemAttr(resourceVPCName, "secondary_cidrs.*", "23.11.0.0/16"),
+				),
+			},
+			{
+				ResourceName:      resourceVPCName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -269,3 +316,34 @@ resource "opentelekomcloud_vpc_v1" "vpc_1" {
   }
 }
 `
+
+const testAccVpcV3MigrateCidr = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name            = "tf_acc_test_v3"
+  description     = "simple description updated"
+  cidr            = "192.168.0.0/16"
+  secondary_cidrs = ["23.8.0.0/16"]
+  shared          = false
+
+  tags = {
+    foo = "bar"
+    key = "value_update"
+  }
+}
+`
+
+const testAccVpcV1SecondaryCidrs = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name            = "tf_acc_test_cidrs"
+  cidr            = "192.168.0.0/16"
+  secondary_cidrs = ["23.9.0.0/16", "23.10.0.0/16"]
+}
+`
+
+const testAccVpcV1SecondaryCidrsUpdate = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name            = "tf_acc_test_cidrs"
+  cidr            = "192.168.0.0/16"
+  secondary_cidrs = ["23.10.0.0/16", "23.11.0.0/16"]
+}
+`
@@ -0,0 +1,49 @@
+package vpcep
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+)
+
+const dataSourceConnectionsName = "data.opentelekomcloud_vpcep_service_connections_v1.pending"
+
+func TestDataSourceVPCEPServiceConnections(t *testing.T) {
+	name := tools.RandomString("tf-test-", 4)
+	t.Parallel()
+	quotas.BookOne(t, serviceQuota)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testServiceConnectionsDSBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceConnectionsName, "connections.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceConnectionsName, "connections.0.endpoint_id",
+						"opentelekomcloud_vpcep_endpoint_v1.endpoint", "id"),
+					resource.TestCheckResourceAttr(dataSourceConnectionsName, "connections.0.status", "pendingAcceptance"),
+					resource.TestCheckResourceAttrSet(dataSourceConnectionsName, "connections.0.domain_id"),
+				),
+			},
+		},
+	})
+}
+
+func testServiceConnectionsDSBasic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_vpcep_service_connections_v1" "pending" {
+  service_id = opentelekomcloud_vpcep_service_v1.service.id
+  status     = "pendingAcceptance"
+
+  depends_on = [opentelekomcloud_vpcep_endpoint_v1.endpoint]
+}
+`, testAccVPCEndpointApproval_Base(name))
+}
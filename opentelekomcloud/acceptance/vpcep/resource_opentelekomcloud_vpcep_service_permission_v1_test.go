@@ -0,0 +1,110 @@
+package vpcep
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/vpcep/v1/endpoints"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common/quotas"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceVPCEPPermissionName = "opentelekomcloud_vpcep_service_permission_v1.permission"
+
+func TestVPCEPServicePermission_basic(t *testing.T) {
+	name := tools.RandomString("tf-test-", 4)
+	t.Parallel()
+	quotas.BookOne(t, serviceQuota)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckVPCEPServicePermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testServicePermissionBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceVPCEPPermissionName, "service_id", resourceVPCEPServiceName, "id"),
+					resource.TestCheckResourceAttr(resourceVPCEPPermissionName, "domain_id", "698f9bf85ca9437a9b2f41132ab3aa0e"),
+					resource.TestCheckResourceAttrSet(resourceVPCEPPermissionName, "permission_id"),
+					resource.TestCheckResourceAttrSet(resourceVPCEPPermissionName, "created_at"),
+					resource.TestCheckResourceAttr(resourceVPCEPServiceName, "whitelist.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceVPCEPPermissionName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVPCEPServicePermissionDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.VpcEpV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating VPCEP v1 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_vpcep_service_permission_v1" {
+			continue
+		}
+		whitelist, err := endpoints.GetWhitelist(client, rs.Primary.Attributes["service_id"])
+		if err != nil {
+			// the service is deleted with the permission
+			continue
+		}
+		for _, p := range whitelist.Permissions {
+			if strings.HasSuffix(p.Permission, rs.Primary.Attributes["domain_id"]) {
+				return fmt.Errorf("VPC EP service permission still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testServicePermissionBasic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_loadbalancer_v2" "lb_1" {
+  vip_subnet_id = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.subnet_id
+}
+
+resource "opentelekomcloud_vpcep_service_v1" "service" {
+  name        = "%s"
+  port_id     = opentelekomcloud_lb_loadbalancer_v2.lb_1.vip_port_id
+  vpc_id      = data.opentelekomcloud_vpc_subnet_v1.shared_subnet.vpc_id
+  server_type = "LB"
+
+  approval_enabled = true
+
+  port {
+    client_port = 80
+    server_port = 8080
+  }
+
+  lifecycle {
+    ignore_changes = [whitelist]
+  }
+}
+
+resource "opentelekomcloud_vpcep_service_permission_v1" "permission" {
+  service_id = opentelekomcloud_vpcep_service_v1.service.id
+  domain_id  = "698f9bf85ca9437a9b2f41132ab3aa0e"
+}
+
+resource "opentelekomcloud_vpcep_service_permission_v1" "permission_2" {
+  service_id = opentelekomcloud_vpcep_service_v1.service.id
+  domain_id  = "5b9c3e6ae23e4a8d8b2c0ac63c1f3f2e"
+}
+`, common.DataSourceSubnet, name)
+}
@@ -0,0 +1,49 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccEvpnConnectionsV5DataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("evpn_acc_conn_%s", acctest.RandString(5))
+	ipAddress := "172.16.1.5"
+	dataSourceName := "data.opentelekomcloud_enterprise_vpn_connections_v5.conn"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEvpnConnectionsDataSource_basic(name, ipAddress),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "connections.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "connections.0.name", name),
+					resource.TestCheckResourceAttrPair(dataSourceName, "connections.0.id",
+						"opentelekomcloud_enterprise_vpn_connection_v5.conn", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "connections.0.gateway_id",
+						"opentelekomcloud_enterprise_vpn_connection_v5.conn", "gateway_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "connections.0.monitor_id",
+						"opentelekomcloud_enterprise_vpn_connection_monitor_v5.cm_1", "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "connections.0.status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "connections.0.monitor_status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "connections.0.ike_version"),
+				),
+			},
+		},
+	})
+}
+
+func testEvpnConnectionsDataSource_basic(name, ip string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_enterprise_vpn_connections_v5" "conn" {
+  connection_id = opentelekomcloud_enterprise_vpn_connection_monitor_v5.cm_1.connection_id
+}
+`, testEvpnConnectionMonitor_basic(name, ip))
+}
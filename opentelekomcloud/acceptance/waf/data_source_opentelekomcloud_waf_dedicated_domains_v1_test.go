@@ -0,0 +1,44 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceWafDedicatedDomainsV1_basic(t *testing.T) {
+	var hostName = fmt.Sprintf("wafd%s", acctest.RandString(5))
+	dataSourceName := "data.opentelekomcloud_waf_dedicated_domains_v1.domains"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafDedicatedDomainsV1_ds(hostName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "domains.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domains.0.id", wafdDomainResourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domains.0.policy_id", wafdDomainResourceName, "policy_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "domains.0.domain", fmt.Sprintf("www.%s.com", hostName)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "domains.0.server.0.address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWafDedicatedDomainsV1_ds(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_waf_dedicated_domains_v1" "domains" {
+  domain    = opentelekomcloud_waf_dedicated_domain_v1.domain_1.domain
+  policy_id = opentelekomcloud_waf_dedicated_domain_v1.domain_1.policy_id
+}
+`, testAccWafDedicatedDomainV1_basic(name))
+}
@@ -0,0 +1,34 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceWafDedicatedEventsV1_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_waf_dedicated_events_v1.events"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafDedicatedEventsV1_ds,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total"),
+				),
+			},
+		},
+	})
+}
+
+const testAccWafDedicatedEventsV1_ds = `
+data "opentelekomcloud_waf_dedicated_events_v1" "events" {
+  recent = "1week"
+  action = "block"
+}
+`
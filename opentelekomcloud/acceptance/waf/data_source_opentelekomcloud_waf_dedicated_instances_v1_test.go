@@ -0,0 +1,50 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+func TestAccDataSourceWafDedicatedInstancesV1_basic(t *testing.T) {
+	var instanceName = fmt.Sprintf("wafd_instance_%s", acctest.RandString(5))
+	dataSourceName := "data.opentelekomcloud_waf_dedicated_instances_v1.instances"
+	arch := "x86"
+	flavor := "s2.large.2"
+	if env.OS_REGION_NAME == "eu-ch2" {
+		arch = "x86_64"
+		flavor = "s3.large.2"
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafDedicatedInstancesV1_ds(instanceName, arch, flavor),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.name", instanceName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.id", wafdInstanceResourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.service_ip"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.vpc_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWafDedicatedInstancesV1_ds(name, arch, flavor string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_waf_dedicated_instances_v1" "instances" {
+  name = opentelekomcloud_waf_dedicated_instance_v1.wafd_1.name
+}
+`, testAccWafDedicatedInstanceV1_basic(name, arch, flavor))
+}
@@ -0,0 +1,44 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceWafDedicatedPoliciesV1_basic(t *testing.T) {
+	var policyName = fmt.Sprintf("wafd_policy_%s", acctest.RandString(5))
+	dataSourceName := "data.opentelekomcloud_waf_dedicated_policies_v1.policies"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafDedicatedPoliciesV1_ds(policyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "policies.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policies.0.id", wafdPolicyResourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.name", policyName),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.protection_mode", "log"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.level", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.options.0.web_attack", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWafDedicatedPoliciesV1_ds(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_waf_dedicated_policies_v1" "policies" {
+  name = opentelekomcloud_waf_dedicated_policy_v1.policy_1.name
+}
+`, testAccWafDedicatedPolicyV1_basic(name))
+}
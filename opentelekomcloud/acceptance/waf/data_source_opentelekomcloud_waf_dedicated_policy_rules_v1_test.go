@@ -0,0 +1,47 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceWafDedicatedPolicyRulesV1_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_waf_dedicated_policy_rules_v1.rules"
+	dataSourceBlacklistName := "data.opentelekomcloud_waf_dedicated_policy_rules_v1.blacklist"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafDedicatedPolicyRulesV1_ds,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "rule_count", "3"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules", wafdPolicyRulesName, "rules"),
+					resource.TestCheckResourceAttr(dataSourceBlacklistName, "items.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceBlacklistName, "items.*", map[string]string{
+						"type":    "blacklist",
+						"enabled": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+var testAccWafDedicatedPolicyRulesV1_ds = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_waf_dedicated_policy_rules_v1" "rules" {
+  policy_id = opentelekomcloud_waf_dedicated_policy_rules_v1.rules.policy_id
+}
+
+data "opentelekomcloud_waf_dedicated_policy_rules_v1" "blacklist" {
+  policy_id = opentelekomcloud_waf_dedicated_policy_rules_v1.rules.policy_id
+  type      = "blacklist"
+}
+`, testAccWafDedicatedPolicyRulesV1Basic)
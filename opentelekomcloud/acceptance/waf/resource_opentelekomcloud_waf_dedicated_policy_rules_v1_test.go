@@ -0,0 +1,195 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf-premium/v1/rules"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const wafdPolicyRulesName = "opentelekomcloud_waf_dedicated_policy_rules_v1.rules"
+
+func TestAccWafDedicatedPolicyRulesV1_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckWafDedicatedPolicyRulesV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafDedicatedPolicyRulesV1Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWafDedicatedPolicyRulesV1Count(wafdPolicyRulesName, 2, 1),
+					resource.TestCheckResourceAttr(wafdPolicyRulesName, "rule_count", "3"),
+				),
+			},
+			{
+				Config: testAccWafDedicatedPolicyRulesV1Update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWafDedicatedPolicyRulesV1Count(wafdPolicyRulesName, 1, 2),
+					resource.TestCheckResourceAttr(wafdPolicyRulesName, "rule_count", "3"),
+				),
+			},
+			{
+				ResourceName:      wafdPolicyRulesName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckWafDedicatedPolicyRulesV1Count(n string, blacklists, customs int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.WafDedicatedV1Client(env.OS_REGION_NAME)
+		if err != nil {
+			return err
+		}
+
+		foundBlacklists, err := rules.ListBlacklists(client, rs.Primary.ID, rules.ListBlacklistOpts{})
+		if err != nil {
+			return err
+		}
+		if len(foundBlacklists) != blacklists {
+			return fmt.Errorf("expected %d blacklist rules, got %d", blacklists, len(foundBlacklists))
+		}
+
+		foundCustoms, err := rules.ListCustoms(client, rs.Primary.ID, rules.ListCustomOpts{})
+		if err != nil {
+			return err
+		}
+		if len(foundCustoms) != customs {
+			return fmt.Errorf("expected %d precise protection rules, got %d", customs, len(foundCustoms))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWafDedicatedPolicyRulesV1Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_waf_dedicated_policy_rules_v1" {
+			continue
+		}
+
+		found, err := rules.ListBlacklists(client, rs.Primary.ID, rules.ListBlacklistOpts{})
+		if err == nil && len(found) != 0 {
+			return fmt.Errorf("waf dedicated policy rules still exist")
+		}
+	}
+
+	return nil
+}
+
+const testAccWafDedicatedPolicyRulesV1Basic = `
+resource "opentelekomcloud_waf_dedicated_policy_v1" "policy_1" {
+  name = "policy_rules"
+}
+
+resource "opentelekomcloud_waf_dedicated_policy_rules_v1" "rules" {
+  policy_id = opentelekomcloud_waf_dedicated_policy_v1.policy_1.id
+
+  rules = jsonencode({
+    blacklist = [
+      {
+        name  = "block-scanner"
+        addr  = "192.168.1.0/24"
+        white = 0
+      },
+      {
+        name    = "allow-office"
+        addr    = "10.10.0.1"
+        white   = 1
+        enabled = false
+      },
+    ]
+    precise_protection = [
+      {
+        description = "block admin"
+        time        = false
+        priority    = 10
+        action = {
+          category = "block"
+        }
+        conditions = [
+          {
+            category        = "url"
+            logic_operation = "prefix"
+            contents        = ["/admin"]
+          },
+        ]
+      },
+    ]
+  })
+}
+`
+
+const testAccWafDedicatedPolicyRulesV1Update = `
+resource "opentelekomcloud_waf_dedicated_policy_v1" "policy_1" {
+  name = "policy_rules"
+}
+
+resource "opentelekomcloud_waf_dedicated_policy_rules_v1" "rules" {
+  policy_id = opentelekomcloud_waf_dedicated_policy_v1.policy_1.id
+
+  rules = jsonencode({
+    blacklist = [
+      {
+        name  = "allow-office"
+        addr  = "10.10.0.1"
+        white = 1
+      },
+    ]
+    precise_protection = [
+      {
+        description = "block admin"
+        time        = false
+        priority    = 20
+        action = {
+          category = "block"
+        }
+        conditions = [
+          {
+            category        = "url"
+            logic_operation = "prefix"
+            contents        = ["/admin"]
+          },
+        ]
+      },
+      {
+        description = "log api"
+        time        = false
+        priority    = 30
+        enabled     = false
+        action = {
+          category = "log"
+        }
+        conditions = [
+          {
+            category        = "url"
+            logic_operation = "prefix"
+            contents        = ["/api"]
+          },
+        ]
+      },
+    ]
+  })
+}
+`
@@ -80,3 +80,13 @@ func Contains(tagSlice []tags.ResourceTag, tag tags.ResourceTag) bool {
 
 	return false
 }
+
+// ContainsAllTags checks that every expected tag is set for the resource
+func ContainsAllTags(resourceTags []tags.ResourceTag, expected []tags.ResourceTag) bool {
+	for _, tag := range expected {
+		if !Contains(resourceTags, tag) {
+			return false
+		}
+	}
+	return true
+}
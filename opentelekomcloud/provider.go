@@ -260,8 +260,17 @@ func Provider() *schema.Provider {
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"opentelekomcloud_antiddos_v1":                       antiddos.DataSourceAntiDdosV1(),
+			"opentelekomcloud_apigw_api_export_v2":               apigw.DataSourceApigwApiExportV2(),
 			"opentelekomcloud_apigw_api_history_v2":              apigw.DataSourceApigwApiHistory(),
+			"opentelekomcloud_apigw_apis_v2":                     apigw.DataSourceApigwApisV2(),
+			"opentelekomcloud_apigw_applications_v2":             apigw.DataSourceApigwApplicationsV2(),
+			"opentelekomcloud_apigw_custom_authorizers_v2":       apigw.DataSourceApigwCustomAuthorizersV2(),
+			"opentelekomcloud_apigw_environments_v2":             apigw.DataSourceApigwEnvironmentsV2(),
 			"opentelekomcloud_apigw_gateway_features_v2":         apigw.DataSourceGatewayFeaturesV2(),
+			"opentelekomcloud_apigw_gateways_v2":                 apigw.DataSourceApigwGatewaysV2(),
+			"opentelekomcloud_apigw_groups_v2":                   apigw.DataSourceApigwGroupsV2(),
+			"opentelekomcloud_apigw_throttling_policies_v2":      apigw.DataSourceApigwThrottlingPoliciesV2(),
+			"opentelekomcloud_apigw_vpc_channels_v2":             apigw.DataSourceApigwVpcChannelsV2(),
 			"opentelekomcloud_cbr_backup_v3":                     cbr.DataSourceCBRBackupsV3(),
 			"opentelekomcloud_cbr_backup_ids_v3":                 cbr.DataSourceCBRBackupsIdsV3(),
 			"opentelekomcloud_cce_cluster_v3":                    cce.DataSourceCCEClusterV3(),
@@ -284,7 +293,10 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_css_certificate_v1":                css.DataSourceCSSCertificateV1(),
 			"opentelekomcloud_css_flavor_v1":                     css.DataSourceCSSFlavorV1(),
 			"opentelekomcloud_cts_tracker_v1":                    cts.DataSourceCTSTrackerV1(),
+			"opentelekomcloud_dc_virtual_gateways_v3":            dcaas.DataSourceVirtualGatewaysV3(),
+			"opentelekomcloud_dc_virtual_interfaces_v3":          dcaas.DataSourceVirtualInterfacesV3(),
 			"opentelekomcloud_direct_connect_v2":                 dcaas.DataSourceDirectConnectV2(),
+			"opentelekomcloud_direct_connects_v2":                dcaas.DataSourceDirectConnectsV2(),
 			"opentelekomcloud_dcs_az_v1":                         dcs.DataSourceDcsAZV1(),
 			"opentelekomcloud_dcs_certificate_v2":                dcs.DataSourceDcsCertificateV2(),
 			"opentelekomcloud_dcs_maintainwindow_v1":             dcs.DataSourceDcsMaintainWindowV1(),
@@ -301,8 +313,14 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_dms_flavor_v2":                     dms.DataSourceDmsFlavorV2(),
 			"opentelekomcloud_dms_maintainwindow_v1":             dms.DataSourceDmsMaintainWindowV1(),
 			"opentelekomcloud_dns_nameservers_v2":                dns.DataSourceDNSNameserversV2(),
+			"opentelekomcloud_dns_zone_file":                     dns.DataSourceDNSZoneFile(),
 			"opentelekomcloud_dns_zone_v2":                       dns.DataSourceDNSZoneV2(),
 			"opentelekomcloud_dws_flavors_v2":                    dws.DataSourceDwsFlavorsV2(),
+			"opentelekomcloud_enterprise_vpn_connections_v5":     vpn.DataSourceEnterpriseConnectionsV5(),
+			"opentelekomcloud_er_attachments_v3":                 er.DataSourceErAttachmentsV3(),
+			"opentelekomcloud_er_effective_routes_v3":            er.DataSourceErEffectiveRoutesV3(),
+			"opentelekomcloud_er_instances_v3":                   er.DataSourceErInstancesV3(),
+			"opentelekomcloud_evs_snapshot_v2":                   evs.DataSourceEvsSnapshotV2(),
 			"opentelekomcloud_evs_volumes_v2":                    evs.DataSourceEvsVolumesV2(),
 			"opentelekomcloud_hss_host_groups_v5":                hss.DataSourceHostGroups(),
 			"opentelekomcloud_hss_quotas_v5":                     hss.DataSourceQuotas(),
@@ -324,9 +342,24 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_lb_certificate_v3":                 elbv3.DataSourceCertificateV3(),
 			"opentelekomcloud_lb_flavor_v3":                      elbv3.DataSourceLBFlavorV3(),
 			"opentelekomcloud_lb_flavors_v3":                     elbv3.DataSourceLBFlavorsV3(),
+			"opentelekomcloud_lb_ipgroup_v3":                     elbv3.DataSourceLBIpGroupV3(),
+			"opentelekomcloud_lb_ipgroups_v3":                    elbv3.DataSourceLBIpGroupsV3(),
 			"opentelekomcloud_lb_loadbalancer_v3":                elbv3.DataSourceLoadBalancerV3(),
 			"opentelekomcloud_lb_listener_v3":                    elbv3.DataSourceListenerV3(),
 			"opentelekomcloud_lb_member_ids_v2":                  elbv2.DataSourceLBMemberIDsV2(),
+			"opentelekomcloud_lb_member_v3":                      elbv3.DataSourceLBMemberV3(),
+			"opentelekomcloud_lb_members_v3":                     elbv3.DataSourceLBMembersV3(),
+			"opentelekomcloud_lb_monitor_v3":                     elbv3.DataSourceLBMonitorV3(),
+			"opentelekomcloud_lb_monitors_v3":                    elbv3.DataSourceLBMonitorsV3(),
+			"opentelekomcloud_lb_policies_v3":                    elbv3.DataSourceLBPoliciesV3(),
+			"opentelekomcloud_lb_policy_v3":                      elbv3.DataSourceLBPolicyV3(),
+			"opentelekomcloud_lb_pool_v3":                        elbv3.DataSourceLBPoolV3(),
+			"opentelekomcloud_lb_pools_v3":                       elbv3.DataSourceLBPoolsV3(),
+			"opentelekomcloud_lb_rule_v3":                        elbv3.DataSourceLBRuleV3(),
+			"opentelekomcloud_lb_rules_v3":                       elbv3.DataSourceLBRulesV3(),
+			"opentelekomcloud_lb_security_policies_v3":           elbv3.DataSourceLBSecurityPoliciesV3(),
+			"opentelekomcloud_lb_security_policy_v3":             elbv3.DataSourceLBSecurityPolicyV3(),
+			"opentelekomcloud_lb_topology_v2":                    elbv2.DataSourceLBTopologyV2(),
 			"opentelekomcloud_nat_gateway_v2":                    nat.DataSourceNatGatewayV2(),
 			"opentelekomcloud_nat_dnat_rules_v2":                 nat.DataSourceDnatRulesV2(),
 			"opentelekomcloud_nat_snat_rules_v2":                 nat.DataSourceSnatRulesV2(),
@@ -354,12 +387,16 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_s3_bucket_object":                  s3.DataSourceS3BucketObject(),
 			"opentelekomcloud_sfs_file_system_v2":                sfs.DataSourceSFSFileSystemV2(),
 			"opentelekomcloud_sfs_turbo_share_v1":                sfs.DataSourceSFSTurboShareV1(),
+			"opentelekomcloud_sfs_turbo_shares_v1":               sfs.DataSourceSFSTurboSharesV1(),
 			"opentelekomcloud_sdrs_domain_v1":                    sdrs.DataSourceSdrsDomainV1(),
 			"opentelekomcloud_vpc_eip_v1":                        vpc.DataSourceVPCEipV1(),
+			"opentelekomcloud_vpc_eips_v1":                       vpc.DataSourceVPCEipsV1(),
+			"opentelekomcloud_vpc_flow_log_records_v1":           vpc.DataSourceVpcFlowLogRecordsV1(),
 			"opentelekomcloud_vpc_v1":                            vpc.DataSourceVirtualPrivateCloudVpcV1(),
 			"opentelekomcloud_vpc_bandwidth":                     vpc.DataSourceBandWidth(),
 			"opentelekomcloud_vpc_bandwidth_v2":                  vpc.DataSourceBandWidthV2(),
 			"opentelekomcloud_vpcep_public_service_v1":           vpcep.DataSourceVPCEPPublicServiceV1(),
+			"opentelekomcloud_vpcep_service_connections_v1":      vpcep.DataSourceVPCEPServiceConnectionsV1(),
 			"opentelekomcloud_vpcep_service_v1":                  vpcep.DataSourceVPCEPServiceV1(),
 			"opentelekomcloud_vbs_backup_v2":                     vbs.DataSourceVBSBackupV2(),
 			"opentelekomcloud_vbs_backup_policy_v2":              vbs.DataSourceVBSBackupPolicyV2(),
@@ -371,6 +408,11 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_vpc_subnet_v1":                     vpc.DataSourceVpcSubnetV1(),
 			"opentelekomcloud_vpc_subnet_ids_v1":                 vpc.DataSourceVpcSubnetIdsV1(),
 			"opentelekomcloud_vpnaas_service_v2":                 vpn.DataSourceVpnServiceV2(),
+			"opentelekomcloud_waf_dedicated_domains_v1":          waf.DataSourceWafDedicatedDomainsV1(),
+			"opentelekomcloud_waf_dedicated_events_v1":           waf.DataSourceWafDedicatedEventsV1(),
+			"opentelekomcloud_waf_dedicated_instances_v1":        waf.DataSourceWafDedicatedInstancesV1(),
+			"opentelekomcloud_waf_dedicated_policies_v1":         waf.DataSourceWafDedicatedPoliciesV1(),
+			"opentelekomcloud_waf_dedicated_policy_rules_v1":     waf.DataSourceWafDedicatedPolicyRulesV1(),
 			"opentelekomcloud_waf_dedicated_reference_tables_v1": waf.DataSourceWafDedicatedRefTablesV1(),
 		},
 
@@ -379,6 +421,7 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_apigw_acl_policy_v2":                       apigw.ResourceAPIAclPolicyV2(),
 			"opentelekomcloud_apigw_acl_policy_associate_v2":             apigw.ResourceAclPolicyAssociateV2(),
 			"opentelekomcloud_apigw_api_v2":                              apigw.ResourceAPIApiV2(),
+			"opentelekomcloud_apigw_api_import_v2":                       apigw.ResourceAPIApiImportV2(),
 			"opentelekomcloud_apigw_api_publishment_v2":                  apigw.ResourceAPIApiPublishmentV2(),
 			"opentelekomcloud_apigw_application_v2":                      apigw.ResourceAPIApplicationV2(),
 			"opentelekomcloud_apigw_application_authorization_v2":        apigw.ResourceAPIAppAuthV2(),
@@ -403,7 +446,9 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_as_policy_v1":                              as.ResourceASPolicy(),
 			"opentelekomcloud_as_policy_v2":                              as.ResourceASPolicyV2(),
 			"opentelekomcloud_blockstorage_volume_v2":                    evs.ResourceBlockStorageVolumeV2(),
+			"opentelekomcloud_cbr_checkpoint_v3":                         cbr.ResourceCBRCheckpointV3(),
 			"opentelekomcloud_cbr_policy_v3":                             cbr.ResourceCBRPolicyV3(),
+			"opentelekomcloud_cbr_restore_v3":                            cbr.ResourceCBRRestoreV3(),
 			"opentelekomcloud_cbr_vault_v3":                              cbr.ResourceCBRVaultV3(),
 			"opentelekomcloud_cce_addon_v3":                              cce.ResourceCCEAddonV3(),
 			"opentelekomcloud_cce_cluster_v3":                            cce.ResourceCCEClusterV3(),
@@ -450,6 +495,9 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_dis_dump_task_v2":                          dis.ResourceDisDumpV2(),
 			"opentelekomcloud_dns_ptrrecord_v2":                          dns.ResourceDNSPtrRecordV2(),
 			"opentelekomcloud_dns_recordset_v2":                          dns.ResourceDNSRecordSetV2(),
+			"opentelekomcloud_dns_recordsets_v2":                         dns.ResourceDNSRecordSetsV2(),
+			"opentelekomcloud_dns_zone_file":                             dns.ResourceDNSZoneFile(),
+			"opentelekomcloud_dns_zone_router_association_v2":            dns.ResourceDNSZoneRouterAssociationV2(),
 			"opentelekomcloud_dns_zone_v2":                               dns.ResourceDNSZoneV2(),
 			"opentelekomcloud_dms_consumer_group_v2":                     dms.ResourceDmsConsumerGroupV2(),
 			"opentelekomcloud_dms_instance_v1":                           dms.ResourceDmsInstancesV1(),
@@ -467,11 +515,16 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_dws_cluster_v1":                            dws.ResourceDcsInstanceV1(),
 			"opentelekomcloud_ecs_instance_v1":                           ecs.ResourceEcsInstanceV1(),
 			"opentelekomcloud_er_association_v3":                         er.ResourceErAssociationV3(),
+			"opentelekomcloud_er_blackhole_routes_v3":                    er.ResourceErBlackholeRoutesV3(),
+			"opentelekomcloud_er_dc_attachment_v3":                       er.ResourceErDcAttachmentV3(),
 			"opentelekomcloud_er_instance_v3":                            er.ResourceErInstanceV3(),
 			"opentelekomcloud_er_propagation_v3":                         er.ResourceErPropagationV3(),
 			"opentelekomcloud_er_static_route_v3":                        er.ResourceErStaticRouteV3(),
 			"opentelekomcloud_er_route_table_v3":                         er.ResourceErRouteTableV3(),
 			"opentelekomcloud_er_vpc_attachment_v3":                      er.ResourceErVpcAttachmentV3(),
+			"opentelekomcloud_er_vpn_attachment_v3":                      er.ResourceErVpnAttachmentV3(),
+			"opentelekomcloud_evs_snapshot_v2":                           evs.ResourceEvsSnapshotV2(),
+			"opentelekomcloud_evs_snapshot_rollback_v2":                  evs.ResourceEvsSnapshotRollbackV2(),
 			"opentelekomcloud_evs_volume_v3":                             evs.ResourceEvsStorageVolumeV3(),
 			"opentelekomcloud_fgs_async_invoke_config_v2":                fgs.ResourceAsyncInvokeConfigurationV2(),
 			"opentelekomcloud_fgs_event_v2":                              fgs.ResourceFgsEventV2(),
@@ -521,6 +574,7 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_lb_listener_v3":                            elbv3.ResourceListenerV3(),
 			"opentelekomcloud_lb_member_v2":                              elbv2.ResourceMemberV2(),
 			"opentelekomcloud_lb_member_v3":                              elbv3.ResourceLBMemberV3(),
+			"opentelekomcloud_lb_members_v3":                             elbv3.ResourceLBMembersV3(),
 			"opentelekomcloud_lb_monitor_v2":                             elbv2.ResourceMonitorV2(),
 			"opentelekomcloud_lb_monitor_v3":                             elbv3.ResourceMonitorV3(),
 			"opentelekomcloud_lb_policy_v3":                              elbv3.ResourceLBPolicyV3(),
@@ -537,6 +591,7 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_nat_gateway_v2":                            nat.ResourceNatGatewayV2(),
 			"opentelekomcloud_nat_dnat_rule_v2":                          nat.ResourceNatDnatRuleV2(),
 			"opentelekomcloud_nat_snat_rule_v2":                          nat.ResourceNatSnatRuleV2(),
+			"opentelekomcloud_network_acl":                               fw.ResourceNetworkACL(),
 			"opentelekomcloud_networking_floatingip_v2":                  vpc.ResourceNetworkingFloatingIPV2(),
 			"opentelekomcloud_networking_floatingip_associate_v2":        vpc.ResourceNetworkingFloatingIPAssociateV2(),
 			"opentelekomcloud_networking_network_v2":                     vpc.ResourceNetworkingNetworkV2(),
@@ -547,6 +602,7 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_networking_router_route_v2":                vpc.ResourceNetworkingRouterRouteV2(),
 			"opentelekomcloud_networking_secgroup_v2":                    vpc.ResourceNetworkingSecGroupV2(),
 			"opentelekomcloud_networking_secgroup_rule_v2":               vpc.ResourceNetworkingSecGroupRuleV2(),
+			"opentelekomcloud_networking_secgroup_rules_v2":              vpc.ResourceNetworkingSecGroupRulesV2(),
 			"opentelekomcloud_networking_subnet_v2":                      vpc.ResourceNetworkingSubnetV2(),
 			"opentelekomcloud_networking_vip_v2":                         vpc.ResourceNetworkingVIPV2(),
 			"opentelekomcloud_networking_vip_associate_v2":               vpc.ResourceNetworkingVIPAssociateV2(),
@@ -575,6 +631,7 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_s3_bucket_object":                          s3.ResourceS3BucketObject(),
 			"opentelekomcloud_sfs_file_system_v2":                        sfs.ResourceSFSFileSystemV2(),
 			"opentelekomcloud_sfs_share_access_rules_v2":                 sfs.ResourceSFSShareAccessRulesV2(),
+			"opentelekomcloud_sfs_turbo_obs_target_v1":                   sfs.ResourceSFSTurboOBSTargetV1(),
 			"opentelekomcloud_sfs_turbo_share_v1":                        sfs.ResourceSFSTurboShareV1(),
 			"opentelekomcloud_smn_topic_v2":                              smn.ResourceTopic(),
 			"opentelekomcloud_smn_topic_attribute_v2":                    smn.ResourceSMNTopicAttributeV2(),
@@ -593,6 +650,7 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_vpc_route_table_v1":                        vpc.ResourceVPCRouteTableV1(),
 			"opentelekomcloud_vpcep_approval_v1":                         vpcep.ResourceVPCEPApprovalV1(),
 			"opentelekomcloud_vpcep_endpoint_v1":                         vpcep.ResourceVPCEPEndpointV1(),
+			"opentelekomcloud_vpcep_service_permission_v1":               vpcep.ResourceVPCEPServicePermissionV1(),
 			"opentelekomcloud_vpcep_service_v1":                          vpcep.ResourceVPCEPServiceV1(),
 			"opentelekomcloud_vpc_route_v2":                              vpc.ResourceVPCRouteV2(),
 			"opentelekomcloud_vpc_subnet_v1":                             vpc.ResourceVpcSubnetV1(),
@@ -626,6 +684,7 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_waf_dedicated_instance_v1":                 waf.ResourceWafDedicatedInstance(),
 			"opentelekomcloud_waf_dedicated_domain_v1":                   waf.ResourceWafDedicatedDomain(),
 			"opentelekomcloud_waf_dedicated_policy_v1":                   waf.ResourceWafDedicatedPolicy(),
+			"opentelekomcloud_waf_dedicated_policy_rules_v1":             waf.ResourceWafDedicatedPolicyRulesV1(),
 			"opentelekomcloud_waf_dedicated_certificate_v1":              waf.ResourceWafDedicatedCertificateV1(),
 			"opentelekomcloud_waf_dedicated_cc_rule_v1":                  waf.ResourceWafDedicatedCcRuleV1(),
 			"opentelekomcloud_waf_dedicated_anti_crawler_rule_v1":        waf.ResourceWafDedicatedAntiCrawlerRuleV1(),
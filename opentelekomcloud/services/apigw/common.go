@@ -1,5 +1,13 @@
 package apigw
 
+import (
+	"bytes"
+	"mime/multipart"
+	"strconv"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
 type (
 	PolicyType string
 	PeriodUnit string
@@ -133,3 +141,103 @@ var (
 		string(ConditionTypeMatching):   "pattern",
 	}
 )
+
+// openApiImportOpts are the options of the OpenAPI import API, which is missing in the SDK
+type openApiImportOpts struct {
+	GroupID    string
+	ExtendMode string
+	ApiMode    string
+	SimpleMode bool
+	MockMode   bool
+	FileName   string
+	Content    []byte
+}
+
+type openApiImportSuccess struct {
+	ID     string `json:"id"`
+	Path   string `json:"path"`
+	Method string `json:"method"`
+	Action string `json:"action"`
+}
+
+type openApiImportFailure struct {
+	Path      string `json:"path"`
+	Method    string `json:"method"`
+	ErrorCode string `json:"error_code"`
+	ErrorMsg  string `json:"error_msg"`
+}
+
+type openApiImportResp struct {
+	GroupID string                 `json:"group_id"`
+	Success []openApiImportSuccess `json:"success"`
+	Failure []openApiImportFailure `json:"failure"`
+}
+
+// importOpenApi creates or updates the APIs of the group from the OpenAPI document
+func importOpenApi(client *golangsdk.ServiceClient, gatewayID string, opts openApiImportOpts) (*openApiImportResp, error) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	fields := map[string]string{
+		"is_create_group": "false",
+		"group_id":        opts.GroupID,
+		"extend_mode":     opts.ExtendMode,
+		"api_mode":        opts.ApiMode,
+		"simple_mode":     strconv.FormatBool(opts.SimpleMode),
+		"mock_mode":       strconv.FormatBool(opts.MockMode),
+	}
+	for k, v := range fields {
+		if err := writer.WriteField(k, v); err != nil {
+			return nil, err
+		}
+	}
+	file, err := writer.CreateFormFile("file_name", opts.FileName)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.Write(opts.Content); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	// POST /v2/{project_id}/apigw/instances/{instance_id}/openapi/import
+	var res openApiImportResp
+	_, err = client.Request("POST", client.ServiceURL("apigw", "instances", gatewayID, "openapi", "import"), &golangsdk.RequestOpts{
+		RawBody:      bytes.NewReader(body.Bytes()),
+		JSONResponse: &res,
+		OkCodes:      []int{200},
+		MoreHeaders:  map[string]string{"Content-Type": writer.FormDataContentType()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// openApiExportOpts is the query of the OpenAPI export API, which is missing in the SDK
+type openApiExportOpts struct {
+	EnvID   string `q:"env_id"`
+	GroupID string `q:"group_id"`
+	Define  string `q:"define,omitempty"`
+	Version string `q:"version,omitempty"`
+	Type    string `q:"type,omitempty"`
+}
+
+// exportOpenApi returns the OpenAPI document with the APIs of the group
+func exportOpenApi(client *golangsdk.ServiceClient, gatewayID string, opts openApiExportOpts) ([]byte, error) {
+	// GET /v2/{project_id}/apigw/instances/{instance_id}/openapi
+	url, err := golangsdk.NewURLBuilder().
+		WithEndpoints("apigw", "instances", gatewayID, "openapi").
+		WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []byte
+	_, err = client.Get(client.ServiceURL(url.String()), &res, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: map[string]string{"Accept": "*/*"},
+	})
+	return res, err
+}
@@ -0,0 +1,97 @@
+package apigw
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceApigwApiExportV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceApiExportV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"define": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "base",
+				ValidateFunc: validation.StringInSlice([]string{
+					"base", "full", "all",
+				}, false),
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "json",
+				ValidateFunc: validation.StringInSlice([]string{
+					"json", "yaml",
+				}, false),
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceApiExportV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.APIGWV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	gatewayID := d.Get("gateway_id").(string)
+	groupID := d.Get("group_id").(string)
+	envID := d.Get("environment_id").(string)
+	content, err := exportOpenApi(client, gatewayID, openApiExportOpts{
+		EnvID:   envID,
+		GroupID: groupID,
+		Define:  d.Get("define").(string),
+		Version: d.Get("version").(string),
+		Type:    d.Get("format").(string),
+	})
+	if err != nil {
+		return fmterr.Errorf("error exporting OpenTelekomCloud APIGW group (%s): %w", groupID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", gatewayID, groupID, envID))
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("content", string(content)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
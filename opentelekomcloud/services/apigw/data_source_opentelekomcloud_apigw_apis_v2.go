@@ -0,0 +1,197 @@
+package apigw
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	apis "github.com/opentelekomcloud/gophertelekomcloud/openstack/apigw/v2/api"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceApigwApisV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceApigwApisV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"api_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"request_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"apis": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_authentication_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authorizer_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backend_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"environment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"publish_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"registered_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApigwApisV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.APIGWV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	allApis, err := apis.List(client, apis.ListOpts{
+		GatewayID: d.Get("gateway_id").(string),
+		GroupID:   d.Get("group_id").(string),
+		ReqMethod: d.Get("request_method").(string),
+		EnvID:     d.Get("environment_id").(string),
+	})
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud APIGW APIs: %w", err)
+	}
+
+	filterData, err := common.FilterSliceWithField(allApis, map[string]interface{}{
+		"ID":   d.Get("api_id").(string),
+		"Name": d.Get("name").(string),
+	})
+	if err != nil {
+		return diag.Errorf("error filtering OpenTelekomCloud APIGW APIs: %s", err)
+	}
+
+	apiList := make([]map[string]interface{}, 0, len(filterData))
+	ids := make([]string, 0, len(filterData))
+	for _, item := range filterData {
+		v := item.(apis.ApiResp)
+		apiList = append(apiList, map[string]interface{}{
+			"id":                           v.ID,
+			"name":                         v.Name,
+			"group_id":                     v.GroupID,
+			"group_name":                   v.GroupName,
+			"type":                         analyseApiType(v.Type),
+			"request_protocol":             v.ReqProtocol,
+			"request_method":               v.ReqMethod,
+			"request_uri":                  v.ReqUri,
+			"security_authentication_type": v.AuthType,
+			"authorizer_id":                v.AuthorizerID,
+			"backend_type":                 v.BackendType,
+			"description":                  v.Description,
+			"tags":                         v.Tags,
+			"environment_id":               v.RunEnvId,
+			"environment_name":             v.RunEnvName,
+			"publish_id":                   v.PublishID,
+			"registered_at":                v.RegisterTime,
+			"updated_at":                   v.UpdateTime,
+		})
+		ids = append(ids, v.ID)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("apis", apiList),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
@@ -0,0 +1,137 @@
+package apigw
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/apigw/v2/app"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceApigwApplicationsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceApigwApplicationsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"application_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"applications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"app_key": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"creator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bind_num": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"registration_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApigwApplicationsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.APIGWV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	allApps, err := app.List(client, app.ListOpts{
+		GatewayID: d.Get("gateway_id").(string),
+		ID:        d.Get("application_id").(string),
+	})
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud APIGW applications: %w", err)
+	}
+
+	filterData, err := common.FilterSliceWithField(allApps, map[string]interface{}{
+		"Name": d.Get("name").(string),
+	})
+	if err != nil {
+		return diag.Errorf("error filtering OpenTelekomCloud APIGW applications: %s", err)
+	}
+
+	applications := make([]map[string]interface{}, 0, len(filterData))
+	ids := make([]string, 0, len(filterData))
+	for _, item := range filterData {
+		v := item.(app.AppResp)
+		applications = append(applications, map[string]interface{}{
+			"id":                v.ID,
+			"name":              v.Name,
+			"description":       v.Description,
+			"app_key":           v.AppKey,
+			"creator":           v.Creator,
+			"status":            v.Status,
+			"bind_num":          v.BindNum,
+			"registration_time": v.RegisterTime,
+			"updated_at":        v.UpdateTime,
+		})
+		ids = append(ids, v.ID)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("applications", applications),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
@@ -0,0 +1,166 @@
+package apigw
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/apigw/v2/authorizer"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceApigwCustomAuthorizersV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceApigwCustomAuthorizersV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"authorizer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"FRONTEND", "BACKEND",
+				}, false),
+			},
+			"authorizers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"function_urn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_body_send": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"user_data": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identity": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"location": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"validation": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApigwCustomAuthorizersV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.APIGWV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	allAuthorizers, err := authorizer.List(client, authorizer.ListOpts{
+		GatewayID: d.Get("gateway_id").(string),
+		ID:        d.Get("authorizer_id").(string),
+		Type:      d.Get("type").(string),
+	})
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud APIGW custom authorizers: %w", err)
+	}
+
+	filterData, err := common.FilterSliceWithField(allAuthorizers, map[string]interface{}{
+		"Name": d.Get("name").(string),
+	})
+	if err != nil {
+		return diag.Errorf("error filtering OpenTelekomCloud APIGW custom authorizers: %s", err)
+	}
+
+	authorizers := make([]map[string]interface{}, 0, len(filterData))
+	ids := make([]string, 0, len(filterData))
+	for _, item := range filterData {
+		v := item.(authorizer.AuthorizerResp)
+		auth := map[string]interface{}{
+			"id":           v.ID,
+			"name":         v.Name,
+			"type":         v.Type,
+			"function_urn": v.FunctionUrn,
+			"user_data":    v.UserData,
+			"identity":     flattenCustomAuthorizerIdentities(v.Identities),
+			"created_at":   v.CreatedAt,
+		}
+		if v.Ttl != nil {
+			auth["ttl"] = *v.Ttl
+		}
+		if v.NeedBody != nil {
+			auth["is_body_send"] = *v.NeedBody
+		}
+		authorizers = append(authorizers, auth)
+		ids = append(ids, v.ID)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("authorizers", authorizers),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
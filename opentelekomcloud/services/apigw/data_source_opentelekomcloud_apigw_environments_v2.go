@@ -0,0 +1,106 @@
+package apigw
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/apigw/v2/env"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceApigwEnvironmentsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceApigwEnvironmentsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"environments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApigwEnvironmentsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.APIGWV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	allEnvs, err := env.List(client, env.ListOpts{
+		GatewayID: d.Get("gateway_id").(string),
+	})
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud APIGW environments: %w", err)
+	}
+
+	filterData, err := common.FilterSliceWithField(allEnvs, map[string]interface{}{
+		"Name": d.Get("name").(string),
+	})
+	if err != nil {
+		return diag.Errorf("error filtering OpenTelekomCloud APIGW environments: %s", err)
+	}
+
+	environments := make([]map[string]interface{}, 0, len(filterData))
+	ids := make([]string, 0, len(filterData))
+	for _, item := range filterData {
+		v := item.(env.EnvResp)
+		environments = append(environments, map[string]interface{}{
+			"id":          v.ID,
+			"name":        v.Name,
+			"description": v.Description,
+			"created_at":  v.CreateTime,
+		})
+		ids = append(ids, v.ID)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("environments", environments),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
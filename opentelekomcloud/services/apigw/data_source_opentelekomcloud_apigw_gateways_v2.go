@@ -0,0 +1,182 @@
+package apigw
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/apigw/v2/gateway"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceApigwGatewaysV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceApigwGatewaysV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"gateways": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"spec_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"maintain_begin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"maintain_end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"supported_features": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"vpc_ingress_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_egress_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApigwGatewaysV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.APIGWV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	allGateways, err := gateway.List(client, gateway.ListOpts{
+		InstanceID: d.Get("gateway_id").(string),
+		Status:     d.Get("status").(string),
+	})
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud APIGW gateways: %w", err)
+	}
+
+	// instance_name query performs fuzzy search
+	filterData, err := common.FilterSliceWithField(allGateways, map[string]interface{}{
+		"InstanceName": d.Get("name").(string),
+	})
+	if err != nil {
+		return diag.Errorf("error filtering OpenTelekomCloud APIGW gateways: %s", err)
+	}
+
+	gateways := make([]map[string]interface{}, 0, len(filterData))
+	ids := make([]string, 0, len(filterData))
+	for _, item := range filterData {
+		// list response contains only the summary of the gateway
+		v, err := gateway.Get(client, item.(gateway.Gateway).ID)
+		if err != nil {
+			return fmterr.Errorf("error retrieving OpenTelekomCloud APIGW gateway (%s): %w", item.(gateway.Gateway).ID, err)
+		}
+		gw := map[string]interface{}{
+			"id":                    v.ID,
+			"name":                  v.InstanceName,
+			"description":           v.Description,
+			"status":                v.Status,
+			"spec_id":               v.Spec,
+			"vpc_id":                v.VpcID,
+			"subnet_id":             v.SubnetID,
+			"security_group_id":     v.SecurityGroupID,
+			"availability_zones":    parseInstanceAvailabilityZones(v.AvailableZoneIDs),
+			"maintain_begin":        v.MaintainBegin,
+			"maintain_end":          v.MaintainEnd,
+			"supported_features":    v.SupportedFeatures,
+			"vpc_ingress_address":   v.IngressIp,
+			"public_egress_address": v.NatEipAddress,
+			"version":               v.InstanceVersion,
+			"created_at":            v.CreateTime,
+		}
+		if len(v.PublicIps) > 0 {
+			gw["public_egress_address"] = v.PublicIps[0].IpAddress
+		}
+		gateways = append(gateways, gw)
+		ids = append(ids, v.ID)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("gateways", gateways),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
@@ -0,0 +1,155 @@
+package apigw
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	throttlingpolicy "github.com/opentelekomcloud/gophertelekomcloud/openstack/apigw/v2/tr_policy"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceApigwThrottlingPoliciesV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceApigwThrottlingPoliciesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"period_unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_api_requests": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_user_requests": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_app_requests": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_ip_requests": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bind_num": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApigwThrottlingPoliciesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.APIGWV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	allPolicies, err := throttlingpolicy.List(client, throttlingpolicy.ListOpts{
+		GatewayID:  d.Get("gateway_id").(string),
+		ThrottleID: d.Get("policy_id").(string),
+	})
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud APIGW throttling policies: %w", err)
+	}
+
+	filterData, err := common.FilterSliceWithField(allPolicies, map[string]interface{}{
+		"Name": d.Get("name").(string),
+	})
+	if err != nil {
+		return diag.Errorf("error filtering OpenTelekomCloud APIGW throttling policies: %s", err)
+	}
+
+	policies := make([]map[string]interface{}, 0, len(filterData))
+	ids := make([]string, 0, len(filterData))
+	for _, item := range filterData {
+		v := item.(throttlingpolicy.ThrottlingResp)
+		var pType string
+		if t := analyseThrottlingPolicyType(v.Type); t != nil {
+			pType = *t
+		}
+		policies = append(policies, map[string]interface{}{
+			"id":                v.ID,
+			"name":              v.Name,
+			"type":              pType,
+			"period":            v.TimeInterval,
+			"period_unit":       v.TimeUnit,
+			"max_api_requests":  v.ApiCallLimits,
+			"max_user_requests": v.UserCallLimits,
+			"max_app_requests":  v.AppCallLimits,
+			"max_ip_requests":   v.IpCallLimits,
+			"bind_num":          v.BindNum,
+			"description":       v.Description,
+			"created_at":        v.CreateTime,
+		})
+		ids = append(ids, v.ID)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("policies", policies),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
@@ -0,0 +1,263 @@
+package apigw
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	apis "github.com/opentelekomcloud/gophertelekomcloud/openstack/apigw/v2/api"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceAPIApiImportV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceApiImportV2Create,
+		ReadContext:   resourceApiImportV2Read,
+		UpdateContext: resourceApiImportV2Update,
+		DeleteContext: resourceApiImportV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"api_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "merge",
+				ValidateFunc: validation.StringInSlice([]string{
+					"merge", "override",
+				}, false),
+			},
+			"extend_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "merge",
+				ValidateFunc: validation.StringInSlice([]string{
+					"merge", "override",
+				}, false),
+			},
+			"simple_mode": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"mock_mode": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"api_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// openApiFileName returns the file name with the extension matching the document format
+func openApiFileName(content string) string {
+	if common.LooksLikeJsonString(strings.TrimSpace(content)) {
+		return "openapi.json"
+	}
+	return "openapi.yaml"
+}
+
+// apiImportKey is the key of the imported API in the `api_ids` map, e.g. `GET /pets`
+func apiImportKey(method, path string) string {
+	return fmt.Sprintf("%s %s", strings.ToUpper(method), path)
+}
+
+func applyApiImport(client *golangsdk.ServiceClient, d *schema.ResourceData) (map[string]string, error) {
+	gatewayID := d.Get("gateway_id").(string)
+	content := d.Get("content").(string)
+	resp, err := importOpenApi(client, gatewayID, openApiImportOpts{
+		GroupID:    d.Get("group_id").(string),
+		ExtendMode: d.Get("extend_mode").(string),
+		ApiMode:    d.Get("api_mode").(string),
+		SimpleMode: d.Get("simple_mode").(bool),
+		MockMode:   d.Get("mock_mode").(bool),
+		FileName:   openApiFileName(content),
+		Content:    []byte(content),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	apiIDs := make(map[string]string, len(resp.Success))
+	for _, api := range resp.Success {
+		log.Printf("[DEBUG] APIGW API %s %s imported with action %s: %s", api.Method, api.Path, api.Action, api.ID)
+		apiIDs[apiImportKey(api.Method, api.Path)] = api.ID
+	}
+
+	if len(resp.Failure) != 0 {
+		var mErr *multierror.Error
+		for _, f := range resp.Failure {
+			mErr = multierror.Append(mErr, fmt.Errorf("%s: %s (%s)", apiImportKey(f.Method, f.Path), f.ErrorMsg, f.ErrorCode))
+		}
+		return apiIDs, mErr
+	}
+	return apiIDs, nil
+}
+
+func resourceApiImportV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.APIGWV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	apiIDs, err := applyApiImport(client, d)
+	if apiIDs == nil {
+		return fmterr.Errorf("error importing OpenTelekomCloud APIGW APIs: %w", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("gateway_id"), d.Get("group_id")))
+	// the successfully imported APIs are kept to be deleted with the resource
+	if setErr := d.Set("api_ids", apiIDs); setErr != nil {
+		return diag.FromErr(setErr)
+	}
+	if err != nil {
+		return fmterr.Errorf("error importing OpenTelekomCloud APIGW APIs: %w", err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV2)
+	return resourceApiImportV2Read(clientCtx, d, meta)
+}
+
+func resourceApiImportV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.APIGWV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	gatewayID := d.Get("gateway_id").(string)
+	apiIDs := make(map[string]string)
+	for key, id := range d.Get("api_ids").(map[string]interface{}) {
+		_, err := apis.Get(client, gatewayID, id.(string))
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Imported APIGW API %s (%s) not found", key, id)
+				continue
+			}
+			return fmterr.Errorf("error retrieving OpenTelekomCloud APIGW API (%s): %w", id, err)
+		}
+		apiIDs[key] = id.(string)
+	}
+	if len(apiIDs) == 0 {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "APIGW imported APIs")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("api_ids", apiIDs),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceApiImportV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.APIGWV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	oldIDs := d.Get("api_ids").(map[string]interface{})
+	apiIDs, err := applyApiImport(client, d)
+	if apiIDs == nil {
+		return fmterr.Errorf("error importing OpenTelekomCloud APIGW APIs: %w", err)
+	}
+	if err != nil {
+		// keep the APIs which failed to be updated
+		for key, id := range oldIDs {
+			if _, ok := apiIDs[key]; !ok {
+				apiIDs[key] = id.(string)
+			}
+		}
+		if setErr := d.Set("api_ids", apiIDs); setErr != nil {
+			return diag.FromErr(setErr)
+		}
+		return fmterr.Errorf("error importing OpenTelekomCloud APIGW APIs: %w", err)
+	}
+
+	// the APIs removed from the document are deleted
+	gatewayID := d.Get("gateway_id").(string)
+	for key, id := range oldIDs {
+		if newID, ok := apiIDs[key]; ok && newID == id.(string) {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting APIGW API %s (%s) removed from the document", key, id)
+		if err := apis.Delete(client, gatewayID, id.(string)); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); !ok {
+				return fmterr.Errorf("error deleting OpenTelekomCloud APIGW API (%s): %w", id, err)
+			}
+		}
+	}
+
+	if err := d.Set("api_ids", apiIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV2)
+	return resourceApiImportV2Read(clientCtx, d, meta)
+}
+
+func resourceApiImportV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.APIGWV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	gatewayID := d.Get("gateway_id").(string)
+	for key, id := range d.Get("api_ids").(map[string]interface{}) {
+		if err := apis.Delete(client, gatewayID, id.(string)); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return fmterr.Errorf("error deleting OpenTelekomCloud APIGW API %s (%s): %w", key, id, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,256 @@
+package cbr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cbr/v3/backups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cbr/v3/checkpoint"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceCBRCheckpointV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCBRCheckpointV3Create,
+		ReadContext:   resourceCBRCheckpointV3Read,
+		DeleteContext: resourceCBRCheckpointV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vault_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"resources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCBRCheckpointV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CbrV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating CbrV3Client: %w", err)
+	}
+
+	opts := checkpoint.CreateOpts{
+		VaultID: d.Get("vault_id").(string),
+		Parameters: checkpoint.CheckpointParam{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+			Resources:   common.ExpandToStringListBySet(d.Get("resources").(*schema.Set)),
+		},
+	}
+
+	cp, err := checkpoint.Create(client, opts)
+	if err != nil {
+		return fmterr.Errorf("error creating CBR checkpoint: %w", err)
+	}
+	d.SetId(cp.ID)
+
+	log.Printf("[DEBUG] Waiting for CBR checkpoint (%s) to become available", cp.ID)
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"protecting"},
+		Target:       []string{"available"},
+		Refresh:      cbrCheckpointV3StateRefreshFunc(client, cp.ID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for CBR checkpoint (%s) to become available: %w", cp.ID, err)
+	}
+
+	return resourceCBRCheckpointV3Read(ctx, d, meta)
+}
+
+func resourceCBRCheckpointV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CbrV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating CbrV3Client: %w", err)
+	}
+
+	cp, err := checkpoint.Get(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "CBR checkpoint")
+	}
+
+	checkpointBackups, err := backups.List(client, backups.ListOpts{CheckpointID: d.Id()})
+	if err != nil {
+		return fmterr.Errorf("error listing backups of CBR checkpoint (%s): %w", d.Id(), err)
+	}
+	if len(checkpointBackups) == 0 && cp.Status != "protecting" {
+		// all backups of the checkpoint were removed, e.g. by retention policy
+		log.Printf("[WARN] CBR checkpoint (%s) has no backups, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	backupList := make([]map[string]interface{}, len(checkpointBackups))
+	for i, backup := range checkpointBackups {
+		backupList[i] = map[string]interface{}{
+			"id":            backup.ID,
+			"resource_id":   backup.ResourceID,
+			"resource_type": backup.ResourceType,
+			"status":        backup.Status,
+		}
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("vault_id", cp.Vault.ID),
+		d.Set("name", cp.ExtraInfo.Name),
+		d.Set("description", cp.ExtraInfo.Description),
+		d.Set("status", cp.Status),
+		d.Set("created_at", cp.CreatedAt),
+		d.Set("backups", backupList),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CBR checkpoint fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCBRCheckpointV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CbrV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating CbrV3Client: %w", err)
+	}
+
+	checkpointBackups, err := backups.List(client, backups.ListOpts{CheckpointID: d.Id()})
+	if err != nil {
+		return fmterr.Errorf("error listing backups of CBR checkpoint (%s): %w", d.Id(), err)
+	}
+
+	for _, backup := range checkpointBackups {
+		if err := backups.Delete(client, backup.ID); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return fmterr.Errorf("error deleting CBR backup (%s): %w", backup.ID, err)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"deleting"},
+		Target:       []string{"deleted"},
+		Refresh:      cbrCheckpointV3BackupsRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		MinTimeout:   3 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for backups of CBR checkpoint (%s) to be deleted: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func cbrCheckpointV3StateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cp, err := checkpoint.Get(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if cp.Status == "error" {
+			return cp, cp.Status, fmt.Errorf("CBR checkpoint is in error state")
+		}
+		return cp, cp.Status, nil
+	}
+}
+
+func cbrCheckpointV3BackupsRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		checkpointBackups, err := backups.List(client, backups.ListOpts{CheckpointID: id})
+		if err != nil {
+			return nil, "", err
+		}
+		if len(checkpointBackups) == 0 {
+			return checkpointBackups, "deleted", nil
+		}
+		return checkpointBackups, "deleting", nil
+	}
+}
@@ -0,0 +1,180 @@
+package cbr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cbr/v3/backups"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceCBRRestoreV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCBRRestoreV3Create,
+		ReadContext:   resourceCBRRestoreV3Read,
+		DeleteContext: resourceCBRRestoreV3Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"backup_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"server_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"server_id", "volume_id"},
+				RequiredWith: []string{"mappings"},
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"mappings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"volume_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"power_on": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCBRRestoreV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CbrV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating CbrV3Client: %w", err)
+	}
+
+	backupID := d.Get("backup_id").(string)
+	mappingsRaw := d.Get("mappings").([]interface{})
+	mappings := make([]backups.BackupRestoreServer, len(mappingsRaw))
+	for i, raw := range mappingsRaw {
+		mapping := raw.(map[string]interface{})
+		mappings[i] = backups.BackupRestoreServer{
+			BackupID: mapping["backup_id"].(string),
+			VolumeID: mapping["volume_id"].(string),
+		}
+	}
+
+	opts := backups.RestoreBackupOpts{
+		Mappings: mappings,
+		PowerOn:  d.Get("power_on").(bool),
+		ServerID: d.Get("server_id").(string),
+		VolumeID: d.Get("volume_id").(string),
+	}
+	log.Printf("[DEBUG] Restore CBR backup (%s) with options: %+v", backupID, opts)
+	if err := backups.RestoreBackup(client, backupID, opts); err != nil {
+		return fmterr.Errorf("error restoring CBR backup (%s): %w", backupID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"restoring"},
+		Target:       []string{"available"},
+		Refresh:      cbrBackupV3StateRefreshFunc(client, backupID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for CBR backup (%s) to be restored: %w", backupID, err)
+	}
+
+	target := d.Get("server_id").(string)
+	if target == "" {
+		target = d.Get("volume_id").(string)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", backupID, target))
+
+	return resourceCBRRestoreV3Read(ctx, d, meta)
+}
+
+func resourceCBRRestoreV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CbrV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating CbrV3Client: %w", err)
+	}
+
+	// restore is a one-time action, so only existence of the backup is checked
+	backup, err := backups.Get(client, d.Get("backup_id").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "CBR backup")
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("resource_type", backup.ResourceType),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CBR restore fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCBRRestoreV3Delete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Restore of CBR backup can't be undone, removing %s from state only", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func cbrBackupV3StateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		backup, err := backups.Get(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if backup.Status == "error" {
+			return backup, backup.Status, fmt.Errorf("CBR backup is in error state")
+		}
+		return backup, backup.Status, nil
+	}
+}
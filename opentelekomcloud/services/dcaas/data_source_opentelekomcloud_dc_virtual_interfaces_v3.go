@@ -0,0 +1,343 @@
+package dcaas
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	virtual_interface "github.com/opentelekomcloud/gophertelekomcloud/openstack/dcaas/v3/virtual-interface"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceVirtualInterfacesV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVirtualInterfacesV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"virtual_interface_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"direct_connect_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vgw_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"virtual_interfaces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"direct_connect_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vgw_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vlan": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bandwidth": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"remote_ep_group": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"service_ep_group": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"local_gateway_v4_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_gateway_v4_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_gateway_v6_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_gateway_v6_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"asn": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"enable_bfd": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enable_nqa": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"extend_attribute": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ha_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ha_mode": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"detect_multiplier": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"min_rx_interval": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"min_tx_interval": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"remote_disclaim": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"local_disclaim": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"lag_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vif_peers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"address_family": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"local_gateway_ip": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"remote_gateway_ip": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"route_mode": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"bgp_asn": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"remote_ep_group": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"service_ep_group": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"device_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"enable_bfd": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"enable_nqa": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"bgp_route_limit": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"bgp_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"vif_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"receive_route_num": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualInterfacesV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.DCaaSV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreateClientV3, err)
+	}
+
+	allInterfaces, err := virtual_interface.List(client, virtual_interface.ListOpts{
+		ID: d.Get("virtual_interface_id").(string),
+	})
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud DC virtual interfaces v3: %w", err)
+	}
+
+	filterData, err := common.FilterSliceWithField(allInterfaces, map[string]interface{}{
+		"Name":            d.Get("name").(string),
+		"DirectConnectId": d.Get("direct_connect_id").(string),
+		"VgwId":           d.Get("vgw_id").(string),
+		"Status":          d.Get("status").(string),
+	})
+	if err != nil {
+		return diag.Errorf("error filtering OpenTelekomCloud DC virtual interfaces v3: %s", err)
+	}
+
+	interfaces := make([]map[string]interface{}, 0, len(filterData))
+	ids := make([]string, 0, len(filterData))
+	for _, item := range filterData {
+		v := item.(virtual_interface.VirtualInterface)
+		interfaces = append(interfaces, map[string]interface{}{
+			"id":                   v.ID,
+			"name":                 v.Name,
+			"description":          v.Description,
+			"direct_connect_id":    v.DirectConnectId,
+			"vgw_id":               v.VgwId,
+			"type":                 v.Type,
+			"route_mode":           v.RouteMode,
+			"vlan":                 v.Vlan,
+			"bandwidth":            v.Bandwidth,
+			"remote_ep_group":      v.RemoteEpGroup,
+			"service_ep_group":     v.ServiceEpGroup,
+			"local_gateway_v4_ip":  v.LocalGatewayV4Ip,
+			"remote_gateway_v4_ip": v.RemoteGatewayV4Ip,
+			"address_family":       v.AddressFamily,
+			"local_gateway_v6_ip":  v.LocalGatewayV6Ip,
+			"remote_gateway_v6_ip": v.RemoteGatewayV6Ip,
+			"asn":                  v.BgpAsn,
+			"enable_bfd":           v.EnableBfd,
+			"enable_nqa":           v.EnableNqa,
+			"extend_attribute":     flattenVifExtendAttribute(v.ExtendAttribute),
+			"lag_id":               v.LagId,
+			"device_id":            v.DeviceId,
+			"status":               v.Status,
+			"created_at":           v.CreatedAt,
+			"updated_at":           v.UpdatedAt,
+			"vif_peers":            flattenVifPeers(v.VifPeers),
+		})
+		ids = append(ids, v.ID)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("virtual_interfaces", interfaces),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
@@ -0,0 +1,186 @@
+package dcaas
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	dcaas "github.com/opentelekomcloud/gophertelekomcloud/openstack/dcaas/v2/direct-connect"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceDirectConnectsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDirectConnectsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"direct_connect_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"direct_connects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bandwidth": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interface_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"redundant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provider_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provider_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hosting_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vlan": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"lag_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDirectConnectsV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DCaaSV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClientV2, err)
+	}
+
+	allConnects, err := dcaas.List(client, d.Get("direct_connect_id").(string))
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud direct connects: %w", err)
+	}
+
+	filterData, err := common.FilterSliceWithField(allConnects, map[string]interface{}{
+		"Name":     d.Get("name").(string),
+		"Status":   d.Get("status").(string),
+		"Location": d.Get("location").(string),
+	})
+	if err != nil {
+		return diag.Errorf("error filtering OpenTelekomCloud direct connects: %s", err)
+	}
+
+	connects := make([]map[string]interface{}, 0, len(filterData))
+	ids := make([]string, 0, len(filterData))
+	for _, item := range filterData {
+		v := item.(dcaas.DirectConnect)
+		connects = append(connects, map[string]interface{}{
+			"id":              v.ID,
+			"name":            v.Name,
+			"description":     v.Description,
+			"type":            v.Type,
+			"port_type":       v.PortType,
+			"bandwidth":       v.Bandwidth,
+			"location":        v.Location,
+			"peer_location":   v.PeerLocation,
+			"device_id":       v.DeviceID,
+			"interface_name":  v.InterfaceName,
+			"redundant_id":    v.RedundantID,
+			"provider_name":   v.Provider,
+			"provider_status": v.ProviderStatus,
+			"hosting_id":      v.HostingID,
+			"vlan":            v.VLAN,
+			"status":          v.Status,
+			"admin_state_up":  v.AdminStateUp,
+			"lag_id":          v.LagID,
+			"create_time":     v.CreateTime,
+		})
+		ids = append(ids, v.ID)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("direct_connects", connects),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
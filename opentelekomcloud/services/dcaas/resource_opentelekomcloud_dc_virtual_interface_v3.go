@@ -28,6 +28,8 @@ func ResourceVirtualInterfaceV3() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: validateNetworkDetection,
+
 		Schema: map[string]*schema.Schema{
 			"direct_connect_id": {
 				Type:     schema.TypeString,
@@ -128,6 +130,42 @@ func ResourceVirtualInterfaceV3() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"extend_attribute": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ha_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ha_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"detect_multiplier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"min_rx_interval": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"min_tx_interval": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_disclaim": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_disclaim": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"lag_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -331,6 +369,7 @@ func resourceVirtualInterfaceV3Read(ctx context.Context, d *schema.ResourceData,
 		d.Set("bgp_md5", vi.BgpMd5),
 		d.Set("enable_bfd", vi.EnableBfd),
 		d.Set("enable_nqa", vi.EnableNqa),
+		d.Set("extend_attribute", flattenVifExtendAttribute(vi.ExtendAttribute)),
 		d.Set("lag_id", vi.LagId),
 		d.Set("device_id", vi.DeviceId),
 		d.Set("status", vi.Status),
@@ -377,6 +416,31 @@ func flattenVifPeers(vifPeers []virtual_interface.VifPeer) []interface{} {
 	return rst
 }
 
+func flattenVifExtendAttribute(attr virtual_interface.VifExtendAttribute) []interface{} {
+	if reflect.DeepEqual(attr, virtual_interface.VifExtendAttribute{}) {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"ha_type":           attr.HaType,
+			"ha_mode":           attr.HaMode,
+			"detect_multiplier": attr.DetectMultiplier,
+			"min_rx_interval":   attr.MinRxInterval,
+			"min_tx_interval":   attr.MinTxInterval,
+			"remote_disclaim":   attr.RemoteDisclaim,
+			"local_disclaim":    attr.LocalDisclaim,
+		},
+	}
+}
+
+func validateNetworkDetection(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("enable_bfd").(bool) && d.Get("enable_nqa").(bool) {
+		return fmt.Errorf("BFD and NQA cannot be enabled at the same time")
+	}
+	return nil
+}
+
 func closeVirtualInterfaceNetworkDetection(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
 	opts := virtual_interface.UpdateOpts{}
 
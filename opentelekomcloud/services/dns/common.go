@@ -1,6 +1,23 @@
 package dns
 
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
 const (
 	errCreationClient = "error creating OpenTelekomCloud DNSv2 client: %w"
 	keyClientV2       = "dns-v2-client"
+
+	// defaultRecordSetLine is the resolution line of record sets created without `line`
+	defaultRecordSetLine = "default_view"
+	// defaultRecordSetWeight is the weight of record sets created without `weight`
+	defaultRecordSetWeight = 1
 )
+
+// dnsV21Client returns a copy of DNS v2 client pointing to v2.1 API,
+// which supports line-based resolution and weighted record sets
+func dnsV21Client(client *golangsdk.ServiceClient) *golangsdk.ServiceClient {
+	v21 := *client
+	v21.ResourceBase = client.Endpoint + "v2.1/"
+	return &v21
+}
@@ -0,0 +1,80 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/recordsets"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/zones"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceDNSZoneFile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSZoneFileRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDNSZoneFileRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DnsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	zone, err := zones.Get(client, zoneID).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving OpenTelekomCloud DNS zone %s: %s", zoneID, err)
+	}
+
+	pages, err := recordsets.ListByZone(client, zoneID, recordsets.ListOpts{}).AllPages()
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud DNS record sets: %s", err)
+	}
+	all, err := recordsets.ExtractRecordSets(pages)
+	if err != nil {
+		return fmterr.Errorf("error extracting OpenTelekomCloud DNS record sets: %s", err)
+	}
+
+	entries := make([]dnsRecordSetEntry, len(all))
+	for i, rs := range all {
+		entries[i] = flattenDNSRecordSetEntry(rs)
+	}
+
+	d.SetId(zoneID)
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", zone.Name),
+		d.Set("content", renderZoneFile(zone.Name, zone.TTL, entries)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -7,10 +7,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/recordsets"
@@ -80,6 +82,18 @@ func ResourceDNSRecordSetV2() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"line": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
 			"value_specs": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -109,16 +123,50 @@ func getRecordSetCreateOpts(d cfg.SchemaOrDiff) RecordSetCreateOpts {
 		}
 	}
 
-	return RecordSetCreateOpts{
-		recordsets.CreateOpts{
+	opts := RecordSetCreateOpts{
+		CreateOpts: recordsets.CreateOpts{
 			Name:        d.Get("name").(string),
 			Description: d.Get("description").(string),
 			Records:     records,
 			TTL:         d.Get("ttl").(int),
 			Type:        recordSetType,
 		},
-		common.MapValueSpecs(d),
+		Line:       d.Get("line").(string),
+		ValueSpecs: common.MapValueSpecs(d),
+	}
+	opts.Weight = configuredWeight(d)
+	return opts
+}
+
+// configuredWeight returns the weight set in the configuration, including explicit `0`
+func configuredWeight(d cfg.SchemaOrDiff) *int {
+	raw, ok := d.(interface{ GetRawConfig() cty.Value })
+	if !ok {
+		return nil
+	}
+	config := raw.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	if v := config.GetAttr("weight"); v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+	weight := d.Get("weight").(int)
+	return &weight
+}
+
+// isLineBasedRecordSet checks if record set uses line-based resolution or weights,
+// which are supported by v2.1 API only
+func isLineBasedRecordSet(d cfg.SchemaOrDiff) bool {
+	_, line := d.GetOk("line")
+	return line || configuredWeight(d) != nil
+}
+
+func recordSetClient(client *golangsdk.ServiceClient, d cfg.SchemaOrDiff) *golangsdk.ServiceClient {
+	if isLineBasedRecordSet(d) {
+		return dnsV21Client(client)
 	}
+	return client
 }
 
 func trimQuotes(s string) string {
@@ -153,7 +201,7 @@ func resourceDNSRecordSetV2Create(ctx context.Context, d *schema.ResourceData, m
 	createOpts := getRecordSetCreateOpts(d)
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	recordSet, err := recordsets.Create(client, zoneID, createOpts).Extract()
+	recordSet, err := recordsets.Create(recordSetClient(client, d), zoneID, createOpts).Extract()
 	if err != nil {
 		return fmterr.Errorf("error creating OpenTelekomCloud DNS record set: %s", err)
 	}
@@ -218,6 +266,28 @@ func resourceDNSRecordSetV2Read(ctx context.Context, d *schema.ResourceData, met
 		return common.CheckDeletedDiag(d, err, "record_set")
 	}
 
+	resourceType, err := getDNSRecordSetResourceType(client, zoneID)
+	if err != nil {
+		return fmterr.Errorf("error getting resource type of DNS record set %s: %s", recordsetID, err)
+	}
+
+	// line-based resolution is available for public zones only and is returned by v2.1 API
+	if resourceType == "DNS-public_recordset" {
+		var line RecordSetLine
+		if err := recordsets.Get(dnsV21Client(client), zoneID, recordsetID).ExtractInto(&line); err != nil {
+			return fmterr.Errorf("error extracting line of OpenTelekomCloud DNS record set (%s): %s", d.Id(), err)
+		}
+		mErr := multierror.Append(
+			d.Set("line", line.Line),
+		)
+		if line.Weight != nil {
+			mErr = multierror.Append(mErr, d.Set("weight", *line.Weight))
+		}
+		if err := mErr.ErrorOrNil(); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	records := make([]string, len(n.Records))
 	if n.Type == "TXT" {
 		for i, record := range n.Records {
@@ -244,10 +314,6 @@ func resourceDNSRecordSetV2Read(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	// save tags
-	resourceType, err := getDNSRecordSetResourceType(client, zoneID)
-	if err != nil {
-		return fmterr.Errorf("error getting resource type of DNS record set %s: %s", recordsetID, err)
-	}
 	resourceTags, err := tags.Get(client, resourceType, recordsetID).Extract()
 	if err != nil {
 		return fmterr.Errorf("error fetching OpenTelekomCloud DNS record set tags: %s", err)
@@ -270,7 +336,7 @@ func resourceDNSRecordSetV2Update(ctx context.Context, d *schema.ResourceData, m
 		return fmterr.Errorf(errCreationClient, err)
 	}
 
-	var updateOpts recordsets.UpdateOpts
+	var updateOpts RecordSetUpdateOpts
 	if d.HasChange("ttl") {
 		updateOpts.TTL = d.Get("ttl").(int)
 	}
@@ -292,6 +358,10 @@ func resourceDNSRecordSetV2Update(ctx context.Context, d *schema.ResourceData, m
 	if d.HasChange("description") {
 		updateOpts.Description = d.Get("description").(string)
 	}
+	if d.HasChange("weight") {
+		weight := d.Get("weight").(int)
+		updateOpts.Weight = &weight
+	}
 
 	// Obtain relevant info from parsing the ID
 	zoneID, recordsetID, err := ParseDNSV2RecordSetID(d.Id())
@@ -301,7 +371,7 @@ func resourceDNSRecordSetV2Update(ctx context.Context, d *schema.ResourceData, m
 
 	log.Printf("[DEBUG] Updating  record set %s with options: %#v", recordsetID, updateOpts)
 
-	_, err = recordsets.Update(client, zoneID, recordsetID, updateOpts).Extract()
+	_, err = recordsets.Update(recordSetClient(client, d), zoneID, recordsetID, updateOpts).Extract()
 	if err != nil {
 		return fmterr.Errorf("error updating OpenTelekomCloud DNS  record set: %s", err)
 	}
@@ -361,7 +431,7 @@ func resourceDNSRecordSetV2Delete(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	err = recordsets.Delete(client, zoneID, recordsetID).ExtractErr()
+	err = recordsets.Delete(recordSetClient(client, d), zoneID, recordsetID).ExtractErr()
 	if err != nil {
 		return fmterr.Errorf("error deleting OpenTelekomCloud DNS record set: %s", err)
 	}
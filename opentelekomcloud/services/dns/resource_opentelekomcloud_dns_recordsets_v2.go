@@ -0,0 +1,550 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/recordsets"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/zones"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func ResourceDNSRecordSetsV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSRecordSetsV2Create,
+		ReadContext:   resourceDNSRecordSetsV2Read,
+		UpdateContext: resourceDNSRecordSetsV2Update,
+		DeleteContext: resourceDNSRecordSetsV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDNSRecordSetsV2Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"recordset": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      resourceDNSRecordSetsV2Hash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"line": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      defaultRecordSetWeight,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+						"records": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  300,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dnsRecordSetEntry is a normalized representation of a single DNS record set,
+// `Weight` is nil for the default weight, so entries without weight, e.g. from zone file, match unweighted ones
+type dnsRecordSetEntry struct {
+	Name        string
+	Type        string
+	Line        string
+	Weight      *int
+	Records     []string
+	TTL         int
+	Description string
+}
+
+// key returns the record set key, weighted record sets with the same name, type and line
+// are distinguished by the weight
+func (e dnsRecordSetEntry) key() string {
+	key := fmt.Sprintf("%s/%s/%s", e.Name, e.Type, e.Line)
+	if e.Weight != nil {
+		key += fmt.Sprintf("/%d", *e.Weight)
+	}
+	return key
+}
+
+// dnsZoneRecordSet is a record set of the zone together with its resolution line and weight
+type dnsZoneRecordSet struct {
+	recordsets.RecordSet
+	Line   string
+	Weight *int
+}
+
+// recordSetLineEntry is used to extract resolution lines from v2.1 API list response
+type recordSetLineEntry struct {
+	ID string `json:"id"`
+	RecordSetLine
+}
+
+func (e dnsRecordSetEntry) equal(other dnsRecordSetEntry) bool {
+	return e.TTL == other.TTL &&
+		e.Description == other.Description &&
+		strings.Join(e.Records, "\n") == strings.Join(other.Records, "\n")
+}
+
+// apiRecords returns records in the format expected by API
+func (e dnsRecordSetEntry) apiRecords() []string {
+	records := make([]string, len(e.Records))
+	for i, record := range e.Records {
+		if e.Type == "TXT" {
+			record = fmt.Sprintf("\"%s\"", record)
+		}
+		records[i] = record
+	}
+	return records
+}
+
+func normalizeRecordSetName(name string) string {
+	name = strings.ToLower(name)
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+	return name
+}
+
+// normalizeRecordSetWeight returns nil for the default weight
+func normalizeRecordSetWeight(weight *int) *int {
+	if weight == nil || *weight == defaultRecordSetWeight {
+		return nil
+	}
+	return weight
+}
+
+// normalizeRecordSetLine returns empty line for the default one, so it matches not set `line`
+func normalizeRecordSetLine(line string) string {
+	if line == defaultRecordSetLine {
+		return ""
+	}
+	return line
+}
+
+func expandDNSRecordSetEntry(raw map[string]interface{}) dnsRecordSetEntry {
+	var records []string
+	switch v := raw["records"].(type) {
+	case *schema.Set:
+		records = common.ExpandToStringListBySet(v)
+	case []interface{}:
+		for _, r := range v {
+			records = append(records, r.(string))
+		}
+	}
+	sort.Strings(records)
+	weight := raw["weight"].(int)
+	return dnsRecordSetEntry{
+		Name:        normalizeRecordSetName(raw["name"].(string)),
+		Type:        strings.ToUpper(raw["type"].(string)),
+		Line:        normalizeRecordSetLine(raw["line"].(string)),
+		Weight:      normalizeRecordSetWeight(&weight),
+		Records:     records,
+		TTL:         raw["ttl"].(int),
+		Description: raw["description"].(string),
+	}
+}
+
+func resourceDNSRecordSetsV2Hash(v interface{}) int {
+	entry := expandDNSRecordSetEntry(v.(map[string]interface{}))
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("%s-", entry.key()))
+	buf.WriteString(fmt.Sprintf("%s-", strings.Join(entry.Records, ",")))
+	buf.WriteString(fmt.Sprintf("%d-", entry.TTL))
+	buf.WriteString(fmt.Sprintf("%s-", entry.Description))
+	return hashcode.String(buf.String())
+}
+
+func expandDNSRecordSetEntries(set *schema.Set) (map[string]dnsRecordSetEntry, error) {
+	entries := make(map[string]dnsRecordSetEntry)
+	for _, raw := range set.List() {
+		entry := expandDNSRecordSetEntry(raw.(map[string]interface{}))
+		if _, ok := entries[entry.key()]; ok {
+			return nil, fmt.Errorf("duplicated record set %s of type %s in line %q with the same weight", entry.Name, entry.Type, entry.Line)
+		}
+		entries[entry.key()] = entry
+	}
+	return entries, nil
+}
+
+// isZoneManagedRecordSet checks if record set is created by the service for the zone,
+// e.g. SOA and NS record sets in the zone apex, which can't be removed
+func isZoneManagedRecordSet(zoneName string, rs recordsets.RecordSet) bool {
+	return (rs.Type == "SOA" || rs.Type == "NS") && normalizeRecordSetName(rs.Name) == normalizeRecordSetName(zoneName)
+}
+
+// zoneRecordSetsClient returns client for managing record sets of the zone:
+// record sets of public zones are managed by v2.1 API, which supports resolution lines
+func zoneRecordSetsClient(client *golangsdk.ServiceClient, zone *zones.Zone) *golangsdk.ServiceClient {
+	if zone.ZoneType == "public" {
+		return dnsV21Client(client)
+	}
+	return client
+}
+
+// listZoneRecordSetGroups returns all user-managed record sets of the zone grouped by record set key.
+// Several record sets can share the same key, e.g. weighted record sets.
+func listZoneRecordSetGroups(client *golangsdk.ServiceClient, zone *zones.Zone) (map[string][]dnsZoneRecordSet, error) {
+	pages, err := recordsets.ListByZone(zoneRecordSetsClient(client, zone), zone.ID, recordsets.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error listing record sets: %w", err)
+	}
+	all, err := recordsets.ExtractRecordSets(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting record sets: %w", err)
+	}
+	var lines struct {
+		RecordSets []recordSetLineEntry `json:"recordsets"`
+	}
+	if err := pages.(recordsets.RecordSetPage).ExtractInto(&lines); err != nil {
+		return nil, fmt.Errorf("error extracting record set lines: %w", err)
+	}
+	lineByID := make(map[string]RecordSetLine, len(lines.RecordSets))
+	for _, l := range lines.RecordSets {
+		lineByID[l.ID] = l.RecordSetLine
+	}
+
+	result := make(map[string][]dnsZoneRecordSet)
+	for _, rs := range all {
+		if isZoneManagedRecordSet(zone.Name, rs) {
+			continue
+		}
+		line := lineByID[rs.ID]
+		zoneRS := dnsZoneRecordSet{RecordSet: rs, Line: line.Line, Weight: line.Weight}
+		key := flattenZoneRecordSet(zoneRS).key()
+		result[key] = append(result[key], zoneRS)
+	}
+	return result, nil
+}
+
+func flattenDNSRecordSetEntry(rs recordsets.RecordSet) dnsRecordSetEntry {
+	records := make([]string, len(rs.Records))
+	for i, record := range rs.Records {
+		if rs.Type == "TXT" {
+			record = trimQuotes(record)
+		}
+		records[i] = record
+	}
+	sort.Strings(records)
+	return dnsRecordSetEntry{
+		Name:        normalizeRecordSetName(rs.Name),
+		Type:        rs.Type,
+		Records:     records,
+		TTL:         rs.TTL,
+		Description: rs.Description,
+	}
+}
+
+// flattenZoneRecordSet returns entry of the record set including its resolution line and weight
+func flattenZoneRecordSet(rs dnsZoneRecordSet) dnsRecordSetEntry {
+	entry := flattenDNSRecordSetEntry(rs.RecordSet)
+	entry.Line = normalizeRecordSetLine(rs.Line)
+	entry.Weight = normalizeRecordSetWeight(rs.Weight)
+	return entry
+}
+
+// applyDNSRecordSets makes the zone record sets to match the desired ones
+func applyDNSRecordSets(ctx context.Context, client *golangsdk.ServiceClient, zoneID string, desired map[string]dnsRecordSetEntry, timeout time.Duration) error {
+	zone, err := zones.Get(client, zoneID).Extract()
+	if err != nil {
+		return err
+	}
+	existing, err := listZoneRecordSetGroups(client, zone)
+	if err != nil {
+		return err
+	}
+	rsClient := zoneRecordSetsClient(client, zone)
+
+	// several record sets with the same name, type, line and weight can't be told apart,
+	// so they are not changed instead of removing all of them except one
+	var ambiguous []string
+	for key, sets := range existing {
+		if len(sets) > 1 {
+			ambiguous = append(ambiguous, key)
+		}
+	}
+	if len(ambiguous) > 0 {
+		sort.Strings(ambiguous)
+		return fmt.Errorf("zone has several record sets with the same name, type, line and weight, "+
+			"which can't be managed authoritatively: %s", strings.Join(ambiguous, ", "))
+	}
+	if zone.ZoneType != "public" {
+		for key, entry := range desired {
+			if entry.Line != "" || entry.Weight != nil {
+				return fmt.Errorf("record set %s: line and weight are supported for public zones only", key)
+			}
+		}
+	}
+
+	keep := make(map[string]dnsZoneRecordSet)
+	for key := range desired {
+		if sets := existing[key]; len(sets) > 0 {
+			keep[key] = sets[0]
+		}
+	}
+
+	var pending []string
+	for key, sets := range existing {
+		for _, rs := range sets {
+			if kept, ok := keep[key]; ok && kept.ID == rs.ID {
+				continue
+			}
+			log.Printf("[DEBUG] Deleting DNS record set %s (%s)", key, rs.ID)
+			if err := recordsets.Delete(rsClient, zoneID, rs.ID).ExtractErr(); err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					continue
+				}
+				return fmt.Errorf("error deleting DNS record set %s: %w", key, err)
+			}
+		}
+	}
+
+	for key, entry := range desired {
+		rs, ok := keep[key]
+		if !ok {
+			log.Printf("[DEBUG] Creating DNS record set %s", key)
+			created, err := recordsets.Create(rsClient, zoneID, RecordSetCreateOpts{
+				CreateOpts: recordsets.CreateOpts{
+					Name:        entry.Name,
+					Type:        entry.Type,
+					Records:     entry.apiRecords(),
+					TTL:         entry.TTL,
+					Description: entry.Description,
+				},
+				Line:   entry.Line,
+				Weight: entry.Weight,
+			}).Extract()
+			if err != nil {
+				return fmt.Errorf("error creating DNS record set %s: %w", key, err)
+			}
+			pending = append(pending, created.ID)
+			continue
+		}
+		if entry.equal(flattenZoneRecordSet(rs)) {
+			continue
+		}
+		log.Printf("[DEBUG] Updating DNS record set %s (%s)", key, rs.ID)
+		_, err := recordsets.Update(rsClient, zoneID, rs.ID, recordsets.UpdateOpts{
+			Records:     entry.apiRecords(),
+			TTL:         entry.TTL,
+			Description: entry.Description,
+		}).Extract()
+		if err != nil {
+			return fmt.Errorf("error updating DNS record set %s: %w", key, err)
+		}
+		pending = append(pending, rs.ID)
+	}
+
+	for _, id := range pending {
+		stateConf := &resource.StateChangeConf{
+			Target:       []string{"ACTIVE"},
+			Pending:      []string{"PENDING"},
+			Refresh:      waitForDNSRecordSet(client, zoneID, id),
+			Timeout:      timeout,
+			Delay:        2 * time.Second,
+			MinTimeout:   2 * time.Second,
+			PollInterval: 2 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for record set (%s) to become ACTIVE: %w", id, err)
+		}
+	}
+	return nil
+}
+
+func resourceDNSRecordSetsV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	desired, err := expandDNSRecordSetEntries(d.Get("recordset").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := applyDNSRecordSets(ctx, client, zoneID, desired, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error managing record sets of OpenTelekomCloud DNS zone %s: %s", zoneID, err)
+	}
+	d.SetId(zoneID)
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV2)
+	return resourceDNSRecordSetsV2Read(clientCtx, d, meta)
+}
+
+func resourceDNSRecordSetsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	zone, err := zones.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "zone")
+	}
+
+	existing, err := listZoneRecordSetGroups(client, zone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// keep names and lines from the configuration if they are equal to the API ones
+	configNames := make(map[string]string)
+	configLines := make(map[string]string)
+	for _, raw := range d.Get("recordset").(*schema.Set).List() {
+		block := raw.(map[string]interface{})
+		name := block["name"].(string)
+		configNames[normalizeRecordSetName(name)] = name
+		configLines[expandDNSRecordSetEntry(block).key()] = block["line"].(string)
+	}
+
+	var result []interface{}
+	for key, sets := range existing {
+		for _, rs := range sets {
+			entry := flattenZoneRecordSet(rs)
+			name := entry.Name
+			if configName, ok := configNames[name]; ok {
+				name = configName
+			}
+			line := entry.Line
+			if configLine, ok := configLines[key]; ok {
+				line = configLine
+			}
+			weight := defaultRecordSetWeight
+			if entry.Weight != nil {
+				weight = *entry.Weight
+			}
+			result = append(result, map[string]interface{}{
+				"name":        name,
+				"type":        entry.Type,
+				"line":        line,
+				"weight":      weight,
+				"records":     entry.Records,
+				"ttl":         entry.TTL,
+				"description": entry.Description,
+			})
+		}
+	}
+
+	if err := d.Set("zone_id", zone.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("region", config.GetRegion(d)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("recordset", result); err != nil {
+		return fmterr.Errorf("error saving record sets of OpenTelekomCloud DNS zone (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDNSRecordSetsV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if d.HasChange("recordset") {
+		desired, err := expandDNSRecordSetEntries(d.Get("recordset").(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := applyDNSRecordSets(ctx, client, d.Id(), desired, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmterr.Errorf("error managing record sets of OpenTelekomCloud DNS zone %s: %s", d.Id(), err)
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV2)
+	return resourceDNSRecordSetsV2Read(clientCtx, d, meta)
+}
+
+func resourceDNSRecordSetsV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if _, err := zones.Get(client, d.Id()).Extract(); err != nil {
+		return common.CheckDeletedDiag(d, err, "zone")
+	}
+
+	if err := applyDNSRecordSets(ctx, client, d.Id(), nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmterr.Errorf("error deleting record sets of OpenTelekomCloud DNS zone %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDNSRecordSetsV2Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("zone_id", d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,75 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/pointerto"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/recordsets"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestDNSRecordSetEntryKey(t *testing.T) {
+	block := func(line string, weight int) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        "WWW.example.com",
+			"type":        "a",
+			"line":        line,
+			"weight":      weight,
+			"records":     []interface{}{"10.0.0.1"},
+			"ttl":         300,
+			"description": "",
+		}
+	}
+	zoneRecordSet := func(line string, weight *int) dnsZoneRecordSet {
+		return dnsZoneRecordSet{
+			RecordSet: recordsets.RecordSet{Name: "www.example.com.", Type: "A", Records: []string{"10.0.0.1"}, TTL: 300},
+			Line:      line,
+			Weight:    weight,
+		}
+	}
+
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		api      dnsZoneRecordSet
+		expected string
+	}{
+		{
+			name:     "default line and weight",
+			config:   block("", defaultRecordSetWeight),
+			api:      zoneRecordSet(defaultRecordSetLine, pointerto.Int(defaultRecordSetWeight)),
+			expected: "www.example.com./A/",
+		},
+		{
+			name:     "private zone without weight",
+			config:   block("", defaultRecordSetWeight),
+			api:      zoneRecordSet("", nil),
+			expected: "www.example.com./A/",
+		},
+		{
+			name:     "custom line",
+			config:   block("Abroad", defaultRecordSetWeight),
+			api:      zoneRecordSet("Abroad", pointerto.Int(defaultRecordSetWeight)),
+			expected: "www.example.com./A/Abroad",
+		},
+		{
+			name:     "zero weight",
+			config:   block("", 0),
+			api:      zoneRecordSet(defaultRecordSetLine, pointerto.Int(0)),
+			expected: "www.example.com./A//0",
+		},
+		{
+			name:     "custom weight",
+			config:   block("Abroad", 10),
+			api:      zoneRecordSet("Abroad", pointerto.Int(10)),
+			expected: "www.example.com./A/Abroad/10",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			th.AssertEquals(t, c.expected, expandDNSRecordSetEntry(c.config).key())
+			th.AssertEquals(t, c.expected, flattenZoneRecordSet(c.api).key())
+		})
+	}
+}
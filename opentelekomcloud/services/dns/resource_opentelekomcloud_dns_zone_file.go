@@ -0,0 +1,209 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/zones"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDNSZoneFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSZoneFileCreate,
+		ReadContext:   resourceDNSZoneFileRead,
+		UpdateContext: resourceDNSZoneFileUpdate,
+		DeleteContext: resourceDNSZoneFileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDNSRecordSetsV2Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"recordset_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// zoneFileRecordSets returns record sets from zone file, which can be managed by the user
+func zoneFileRecordSets(content string, zone *zones.Zone) (map[string]dnsRecordSetEntry, error) {
+	entries, err := parseZoneFile(content, zone.Name, zone.TTL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing zone file: %w", err)
+	}
+	result := make(map[string]dnsRecordSetEntry)
+	for _, entry := range entries {
+		if (entry.Type == "SOA" || entry.Type == "NS") && entry.Name == normalizeRecordSetName(zone.Name) {
+			continue
+		}
+		result[entry.key()] = entry
+	}
+	return result, nil
+}
+
+func applyDNSZoneFile(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData, timeout time.Duration) error {
+	zoneID := d.Get("zone_id").(string)
+	zone, err := zones.Get(client, zoneID).Extract()
+	if err != nil {
+		return err
+	}
+	desired, err := zoneFileRecordSets(d.Get("content").(string), zone)
+	if err != nil {
+		return err
+	}
+	return applyDNSRecordSets(ctx, client, zoneID, desired, timeout)
+}
+
+func resourceDNSZoneFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if err := applyDNSZoneFile(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error applying zone file to OpenTelekomCloud DNS zone: %s", err)
+	}
+	d.SetId(d.Get("zone_id").(string))
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV2)
+	return resourceDNSZoneFileRead(clientCtx, d, meta)
+}
+
+func resourceDNSZoneFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	zone, err := zones.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "zone")
+	}
+
+	existing, err := listZoneRecordSetGroups(client, zone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	actual := make(map[string]dnsRecordSetEntry)
+	entries := make([]dnsRecordSetEntry, 0, len(existing))
+	// zone file can't describe several record sets with the same key, e.g. weighted ones
+	duplicated := false
+	for key, sets := range existing {
+		for _, rs := range sets {
+			entry := flattenZoneRecordSet(rs)
+			actual[key] = entry
+			entries = append(entries, entry)
+		}
+		duplicated = duplicated || len(sets) > 1
+	}
+
+	// keep zone file from the state if it matches the remote record sets,
+	// otherwise render actual record sets to show the drift
+	content := d.Get("content").(string)
+	stored, err := zoneFileRecordSets(content, zone)
+	if err != nil || duplicated || !equalDNSRecordSetEntries(stored, actual) {
+		content = renderZoneFile(zone.Name, zone.TTL, entries)
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("zone_id", zone.ID),
+		d.Set("content", content),
+		d.Set("recordset_count", len(entries)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func equalDNSRecordSetEntries(a, b map[string]dnsRecordSetEntry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, entry := range a {
+		other, ok := b[key]
+		if !ok || !entry.equal(other) {
+			return false
+		}
+	}
+	return true
+}
+
+func resourceDNSZoneFileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if d.HasChange("content") {
+		if err := applyDNSZoneFile(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmterr.Errorf("error applying zone file to OpenTelekomCloud DNS zone: %s", err)
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV2)
+	return resourceDNSZoneFileRead(clientCtx, d, meta)
+}
+
+func resourceDNSZoneFileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if _, err := zones.Get(client, d.Id()).Extract(); err != nil {
+		return common.CheckDeletedDiag(d, err, "zone")
+	}
+
+	if err := applyDNSRecordSets(ctx, client, d.Id(), nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmterr.Errorf("error deleting record sets of OpenTelekomCloud DNS zone %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
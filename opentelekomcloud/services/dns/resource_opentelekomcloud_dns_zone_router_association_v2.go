@@ -0,0 +1,185 @@
+package dns
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/zones"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDNSZoneRouterAssociationV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSZoneRouterAssociationV2Create,
+		ReadContext:   resourceDNSZoneRouterAssociationV2Read,
+		DeleteContext: resourceDNSZoneRouterAssociationV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: common.ImportByPath("zone_id", "router_id"),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"router_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"router_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSZoneRouterAssociationV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	routerRegion := d.Get("router_region").(string)
+	if routerRegion == "" {
+		routerRegion = config.GetRegion(d)
+	}
+	opts := zones.RouterOpts{
+		RouterID:     d.Get("router_id").(string),
+		RouterRegion: routerRegion,
+	}
+
+	log.Printf("[DEBUG] Creating AssociateZone Options: %#v", opts)
+	if _, err := zones.AssociateZone(client, zoneID, opts).Extract(); err != nil {
+		return fmterr.Errorf("error associating OpenTelekomCloud DNS zone (%s) with router (%s): %s",
+			zoneID, opts.RouterID, logHttpError(err))
+	}
+
+	if err := common.SetComplexID(d, "zone_id", "router_id"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Waiting for AssociateZone (%s) to Router (%s) become ACTIVE", zoneID, opts.RouterID)
+	stateConf := &resource.StateChangeConf{
+		Target:       []string{"ACTIVE"},
+		Pending:      []string{"PENDING"},
+		Refresh:      waitForDNSZoneRouter(client, zoneID, opts.RouterID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		MinTimeout:   3 * time.Second,
+		PollInterval: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for AssociateZone (%s) to Router (%s) become ACTIVE: %s",
+			zoneID, opts.RouterID, err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV2)
+	return resourceDNSZoneRouterAssociationV2Read(clientCtx, d, meta)
+}
+
+func resourceDNSZoneRouterAssociationV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	zone, err := zones.Get(client, d.Get("zone_id").(string)).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "zone")
+	}
+
+	routerID := d.Get("router_id").(string)
+	for _, router := range zone.Routers {
+		if router.RouterID != routerID {
+			continue
+		}
+		mErr := multierror.Append(
+			d.Set("region", config.GetRegion(d)),
+			d.Set("router_region", router.RouterRegion),
+			d.Set("status", router.Status),
+		)
+		if err := mErr.ErrorOrNil(); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Router (%s) is not associated with DNS zone (%s), removing from state", routerID, zone.ID)
+	d.SetId("")
+	return nil
+}
+
+func resourceDNSZoneRouterAssociationV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.DnsV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	opts := zones.RouterOpts{
+		RouterID:     d.Get("router_id").(string),
+		RouterRegion: d.Get("router_region").(string),
+	}
+
+	log.Printf("[DEBUG] Deleting DisassociateZone Options: %#v", opts)
+	if _, err := zones.DisassociateZone(client, zoneID, opts).Extract(); err != nil {
+		return common.CheckDeletedDiag(d, err, "DNS zone router association")
+	}
+
+	log.Printf("[DEBUG] Waiting for DisassociateZone (%s) to Router (%s) become DELETED", zoneID, opts.RouterID)
+	stateConf := &resource.StateChangeConf{
+		Target:       []string{"DELETED"},
+		Pending:      []string{"ACTIVE", "PENDING", "ERROR"},
+		Refresh:      waitForDNSZoneRouter(client, zoneID, opts.RouterID),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		MinTimeout:   3 * time.Second,
+		PollInterval: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for DisassociateZone (%s) to Router (%s) become DELETED: %s",
+			zoneID, opts.RouterID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
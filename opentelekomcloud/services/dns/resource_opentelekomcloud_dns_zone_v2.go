@@ -540,22 +540,32 @@ func resourceGetDNSRouters(client *golangsdk.ServiceClient, d *schema.ResourceDa
 		i++
 	}
 
-	// get disassociateMap
+	// get disassociateMap, only routers removed from the configuration are disassociated,
+	// so associations managed by `opentelekomcloud_dns_zone_router_association_v2` are kept
+	oldRaw, _ := d.GetChange("router")
 	disassociateMap := make(map[string]zones.RouterOpts)
-	for _, raw := range n.Routers {
-		// Check if api is found in local
+	for _, rawOld := range oldRaw.(*schema.Set).List() {
+		old := rawOld.(map[string]interface{})
+		routerID := old["router_id"].(string)
+		// Check if old is found in local
 		found := false
 		for _, local := range localRouters {
-			if raw.RouterID == local.RouterID {
+			if routerID == local.RouterID {
 				found = true
 				break
 			}
 		}
-		// If api is not found in local
-		if !found {
-			disassociateMap[raw.RouterID] = zones.RouterOpts{
-				RouterID:     raw.RouterID,
-				RouterRegion: raw.RouterRegion,
+		if found {
+			continue
+		}
+		// If old is still associated in api
+		for _, raw := range n.Routers {
+			if raw.RouterID == routerID {
+				disassociateMap[raw.RouterID] = zones.RouterOpts{
+					RouterID:     raw.RouterID,
+					RouterRegion: raw.RouterRegion,
+				}
+				break
 			}
 		}
 	}
@@ -12,6 +12,11 @@ import (
 // RecordSetCreateOpts represents the attributes used when creating a new DNS record set.
 type RecordSetCreateOpts struct {
 	recordsets.CreateOpts
+	// Line is the resolution line of the record set, supported by v2.1 API only.
+	Line string `json:"line,omitempty"`
+	// Weight is the weight of the record set, supported by v2.1 API only.
+	Weight *int `json:"weight,omitempty"`
+
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
 }
 
@@ -54,3 +59,31 @@ func (opts ZoneCreateOpts) ToZoneCreateMap() (map[string]interface{}, error) {
 
 	return nil, fmt.Errorf("expected map but got %T", b[""])
 }
+
+// RecordSetUpdateOpts represents the attributes used when updating a DNS record set.
+type RecordSetUpdateOpts struct {
+	recordsets.UpdateOpts
+	// Weight is the weight of the record set, supported by v2.1 API only.
+	Weight *int `json:"weight,omitempty"`
+}
+
+// ToRecordSetUpdateMap casts an UpdateOpts struct to a map.
+// It overrides recordsets.ToRecordSetUpdateMap to add the Weight field.
+func (opts RecordSetUpdateOpts) ToRecordSetUpdateMap() (map[string]interface{}, error) {
+	b, err := opts.UpdateOpts.ToRecordSetUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Weight != nil {
+		b["weight"] = *opts.Weight
+	}
+
+	return b, nil
+}
+
+// RecordSetLine represents line-based resolution attributes of a DNS record set returned by v2.1 API.
+type RecordSetLine struct {
+	Line   string `json:"line"`
+	Weight *int   `json:"weight"`
+}
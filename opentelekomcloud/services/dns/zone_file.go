@@ -0,0 +1,316 @@
+package dns
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// parseZoneFile parses RFC 1035 zone file into the list of record sets.
+// Only the subset of the format required for record sets migration is supported:
+// `$ORIGIN` and `$TTL` directives, comments, multi-line records in parentheses,
+// relative and `@` owner names.
+func parseZoneFile(content, origin string, defaultTTL int) ([]dnsRecordSetEntry, error) {
+	origin = normalizeRecordSetName(origin)
+
+	lines, err := joinZoneFileLines(content)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]*dnsRecordSetEntry)
+	var order []string
+	owner := ""
+	for _, line := range lines {
+		tokens, err := tokenizeZoneFileLine(line.text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.number, err)
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(tokens[0]) {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: invalid $ORIGIN directive", line.number)
+			}
+			origin = qualifyZoneFileName(tokens[1], origin)
+			continue
+		case "$TTL":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: invalid $TTL directive", line.number)
+			}
+			ttl, err := parseZoneFileTTL(tokens[1])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line.number, err)
+			}
+			defaultTTL = ttl
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, fmt.Errorf("line %d: %s directive is not supported", line.number, tokens[0])
+		}
+
+		if !line.continuation {
+			owner = qualifyZoneFileName(tokens[0], origin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: record without owner name", line.number)
+		}
+
+		ttl := defaultTTL
+		// TTL and class can be set in any order before the type
+		for len(tokens) > 0 {
+			if strings.EqualFold(tokens[0], "IN") {
+				tokens = tokens[1:]
+				continue
+			}
+			if v, err := parseZoneFileTTL(tokens[0]); err == nil {
+				ttl = v
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: record type and data are required", line.number)
+		}
+
+		recordType := strings.ToUpper(tokens[0])
+		record, err := zoneFileRecordData(recordType, tokens[1:], origin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.number, err)
+		}
+
+		key := fmt.Sprintf("%s/%s", owner, recordType)
+		entry, ok := entries[key]
+		if !ok {
+			entry = &dnsRecordSetEntry{
+				Name: owner,
+				Type: recordType,
+				TTL:  ttl,
+			}
+			entries[key] = entry
+			order = append(order, key)
+		}
+		entry.Records = append(entry.Records, record)
+	}
+
+	result := make([]dnsRecordSetEntry, len(order))
+	for i, key := range order {
+		entry := *entries[key]
+		sort.Strings(entry.Records)
+		result[i] = entry
+	}
+	return result, nil
+}
+
+type zoneFileLine struct {
+	number       int
+	text         string
+	continuation bool
+}
+
+// joinZoneFileLines removes comments and joins records split with parentheses.
+// Parentheses and semicolons inside quoted strings or escaped with `\` are kept as is.
+func joinZoneFileLines(content string) ([]zoneFileLine, error) {
+	var result []zoneFileLine
+	var current *zoneFileLine
+	depth := 0
+	for i, raw := range strings.Split(content, "\n") {
+		text, lineDepth, err := scanZoneFileLine(strings.TrimRight(raw, "\r"), depth)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		if depth > 0 {
+			current.text += " " + text
+		} else {
+			if strings.TrimSpace(text) == "" {
+				if lineDepth != 0 {
+					return nil, fmt.Errorf("line %d: record without owner name", i+1)
+				}
+				continue
+			}
+			current = &zoneFileLine{
+				number:       i + 1,
+				text:         text,
+				continuation: text[0] == ' ' || text[0] == '\t',
+			}
+		}
+		depth = lineDepth
+		if depth == 0 {
+			result = append(result, *current)
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", current.number)
+	}
+	return result, nil
+}
+
+// scanZoneFileLine strips the comment of the line and replaces grouping parentheses with spaces.
+// It returns the cleaned line and parentheses depth at the end of the line.
+func scanZoneFileLine(line string, depth int) (string, int, error) {
+	var b strings.Builder
+	quoted, escaped := false, false
+	for _, c := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == ';':
+			return b.String(), depth, nil
+		case c == '(':
+			depth++
+			c = ' '
+		case c == ')':
+			depth--
+			if depth < 0 {
+				return "", 0, fmt.Errorf("unbalanced parentheses")
+			}
+			c = ' '
+		}
+		b.WriteRune(c)
+	}
+	return b.String(), depth, nil
+}
+
+// tokenizeZoneFileLine splits line by whitespaces keeping quoted strings and escaped characters together
+func tokenizeZoneFileLine(line string) ([]string, error) {
+	var tokens []string
+	var current strings.Builder
+	quoted, escaped := false, false
+	for _, c := range line {
+		switch {
+		case escaped:
+			escaped = false
+			current.WriteRune(c)
+		case c == '\\':
+			escaped = true
+			current.WriteRune(c)
+		case c == '"':
+			quoted = !quoted
+			current.WriteRune(c)
+		case (c == ' ' || c == '\t') && !quoted:
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(c)
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quoted string")
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+	return tokens, nil
+}
+
+// parseZoneFileTTL parses TTL value in seconds or in BIND format, e.g. `1h30m`
+func parseZoneFileTTL(value string) (int, error) {
+	if ttl, err := strconv.Atoi(value); err == nil {
+		return ttl, nil
+	}
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, number := 0, ""
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= '0' && c <= '9' {
+			number += string(c)
+			continue
+		}
+		multiplier, ok := units[c|0x20]
+		if !ok || number == "" {
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+		n, _ := strconv.Atoi(number)
+		total += n * multiplier
+		number = ""
+	}
+	if number != "" {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+	return total, nil
+}
+
+func qualifyZoneFileName(name, origin string) string {
+	if name == "@" {
+		return origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return strings.ToLower(name)
+	}
+	return strings.ToLower(fmt.Sprintf("%s.%s", name, origin))
+}
+
+// zoneFileRecordData returns record value in the format used by the record set API
+func zoneFileRecordData(recordType string, data []string, origin string) (string, error) {
+	// positions of domain names in the record data
+	namePositions := map[string]int{
+		"CNAME": 0,
+		"NS":    0,
+		"PTR":   0,
+		"MX":    1,
+		"SRV":   3,
+	}
+
+	switch recordType {
+	case "TXT":
+		var parts []string
+		for _, token := range data {
+			parts = append(parts, trimQuotes(token))
+		}
+		return strings.Join(parts, ""), nil
+	case "CAA":
+		if len(data) != 3 {
+			return "", fmt.Errorf("invalid CAA record data")
+		}
+		return fmt.Sprintf("%s %s \"%s\"", data[0], data[1], trimQuotes(data[2])), nil
+	}
+
+	if pos, ok := namePositions[recordType]; ok {
+		if len(data) <= pos {
+			return "", fmt.Errorf("invalid %s record data", recordType)
+		}
+		data[pos] = qualifyZoneFileName(data[pos], origin)
+	}
+	return strings.Join(data, " "), nil
+}
+
+// renderZoneFile renders record sets as RFC 1035 zone file
+func renderZoneFile(origin string, defaultTTL int, entries []dnsRecordSetEntry) string {
+	sorted := make([]dnsRecordSetEntry, len(entries))
+	copy(sorted, entries)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		// SOA record must be the first one
+		if (sorted[i].Type == "SOA") != (sorted[j].Type == "SOA") {
+			return sorted[i].Type == "SOA"
+		}
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].Type < sorted[j].Type
+	})
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("$ORIGIN %s\n", normalizeRecordSetName(origin)))
+	b.WriteString(fmt.Sprintf("$TTL %d\n", defaultTTL))
+	for _, entry := range sorted {
+		for _, record := range entry.Records {
+			if entry.Type == "TXT" {
+				record = fmt.Sprintf("\"%s\"", record)
+			}
+			b.WriteString(fmt.Sprintf("%s\t%d\tIN\t%s\t%s\n", entry.Name, entry.TTL, entry.Type, record))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,114 @@
+package dns
+
+import (
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	cases := []struct {
+		name     string
+		content  string
+		expected []dnsRecordSetEntry
+	}{
+		{
+			name: "multi-line SOA",
+			content: `
+$TTL 3600
+@  IN  SOA  ns1.example.com. admin.example.com. (
+            2024010101 ; serial
+            7200       ; refresh
+            3600       ; retry
+            1209600    ; expire
+            300 )      ; minimum
+`,
+			expected: []dnsRecordSetEntry{
+				{
+					Name:    "example.com.",
+					Type:    "SOA",
+					TTL:     3600,
+					Records: []string{"ns1.example.com. admin.example.com. 2024010101 7200 3600 1209600 300"},
+				},
+			},
+		},
+		{
+			name: "quoted TXT with parentheses and semicolons",
+			content: `
+txt   IN  TXT  "v=foo (bar); baz"
+open  IN  TXT  "unbalanced ( inside"
+multi IN  TXT  ( "part one;"
+                 "part two" ) ; comment
+esc   IN  TXT  "say \"hi\" (now)"
+`,
+			expected: []dnsRecordSetEntry{
+				{Name: "txt.example.com.", Type: "TXT", TTL: 300, Records: []string{"v=foo (bar); baz"}},
+				{Name: "open.example.com.", Type: "TXT", TTL: 300, Records: []string{"unbalanced ( inside"}},
+				{Name: "multi.example.com.", Type: "TXT", TTL: 300, Records: []string{"part one;part two"}},
+				{Name: "esc.example.com.", Type: "TXT", TTL: 300, Records: []string{`say \"hi\" (now)`}},
+			},
+		},
+		{
+			name: "ORIGIN and TTL directives",
+			content: `
+$ORIGIN example.com.
+$TTL 1h
+www          A  10.0.0.1
+$ORIGIN sub.example.com.
+host  60  IN A  10.0.0.2
+$TTL 2d
+db           A  10.0.0.3
+`,
+			expected: []dnsRecordSetEntry{
+				{Name: "www.example.com.", Type: "A", TTL: 3600, Records: []string{"10.0.0.1"}},
+				{Name: "host.sub.example.com.", Type: "A", TTL: 60, Records: []string{"10.0.0.2"}},
+				{Name: "db.sub.example.com.", Type: "A", TTL: 172800, Records: []string{"10.0.0.3"}},
+			},
+		},
+		{
+			name: "relative names",
+			content: `
+@      IN MX     10 mail
+mail   IN A      10.0.0.3
+       IN A      10.0.0.4
+alias  IN CNAME  www
+ext    IN CNAME  other.org.
+_sip._tcp IN SRV 10 60 5060 sip
+`,
+			expected: []dnsRecordSetEntry{
+				{Name: "example.com.", Type: "MX", TTL: 300, Records: []string{"10 mail.example.com."}},
+				{Name: "mail.example.com.", Type: "A", TTL: 300, Records: []string{"10.0.0.3", "10.0.0.4"}},
+				{Name: "alias.example.com.", Type: "CNAME", TTL: 300, Records: []string{"www.example.com."}},
+				{Name: "ext.example.com.", Type: "CNAME", TTL: 300, Records: []string{"other.org."}},
+				{Name: "_sip._tcp.example.com.", Type: "SRV", TTL: 300, Records: []string{"10 60 5060 sip.example.com."}},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			entries, err := parseZoneFile(c.content, "example.com", 300)
+			th.AssertNoErr(t, err)
+			th.AssertDeepEquals(t, c.expected, entries)
+		})
+	}
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	cases := map[string]string{
+		"unbalanced closing parenthesis": "www IN A 10.0.0.1 )\n",
+		"unclosed parenthesis":           "www IN TXT ( \"text\"\n",
+		"unterminated quoted string":     "www IN TXT \"text\n",
+		"include directive":              "$INCLUDE other.zone\n",
+		"missing record data":            "www IN A\n",
+	}
+
+	for name, content := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseZoneFile(content, "example.com", 300)
+			if err == nil {
+				t.Fatalf("expected error for %q", content)
+			}
+		})
+	}
+}
@@ -1,6 +1,35 @@
 package v2
 
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/whitelists"
+)
+
 const (
 	ErrCreationV2Client = "error creating OpenTelekomCloud ELB V2 client: %w"
 	keyClient           = "lbv2-client"
 )
+
+type whitelistListOpts struct {
+	ListenerID string `q:"listener_id"`
+}
+
+// listListenerWhitelists returns the whitelists of the listener, listing is not supported by the SDK
+func listListenerWhitelists(client *golangsdk.ServiceClient, listenerID string) ([]whitelists.Whitelist, error) {
+	url, err := golangsdk.NewURLBuilder().
+		WithEndpoints("lbaas", "whitelists").
+		WithQueryParams(&whitelistListOpts{ListenerID: listenerID}).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL(url.String()), &r.Body, nil)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res []whitelists.Whitelist
+	err = r.ExtractIntoSlicePtr(&res, "whitelists")
+	return res, err
+}
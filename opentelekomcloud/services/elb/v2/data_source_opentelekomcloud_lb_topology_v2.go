@@ -0,0 +1,767 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	vpcsubnets "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/monitors"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/subnets"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// DataSourceLBTopologyV2 reads the whole ELB v2 load balancer and returns it
+// in the structure of ELB v3 resources to simplify migration.
+// All references between the objects use the v2 IDs.
+func DataSourceLBTopologyV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBTopologyV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"loadbalancer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"loadbalancer": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"router_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"listeners": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"default_pool_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"http2_enable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"default_tls_container_ref": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_ca_tls_container_ref": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sni_container_refs": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"tls_ciphers_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ip_group": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"enable": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"pools": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lb_algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"listener_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"session_persistence": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"cookie_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"members": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"protocol_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"subnet_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"weight": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"monitor": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"delay": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"timeout": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"max_retries": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"http_method": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"url_path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"expected_codes": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"domain_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"monitor_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"listener_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"position": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"redirect_pool_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"redirect_listener_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rules": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"compare_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"ipgroups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"listener_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_list": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"warnings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// v3ListenerProtocol converts v2 listener protocol to the v3 one
+func v3ListenerProtocol(protocol string) string {
+	if protocol == "TERMINATED_HTTPS" {
+		return "HTTPS"
+	}
+	return protocol
+}
+
+func dataSourceLBTopologyV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+	client, err := config.ElbV2Client(region)
+	if err != nil {
+		return fmterr.Errorf(ErrCreationV2Client, err)
+	}
+
+	lbID := d.Get("loadbalancer_id").(string)
+	lb, err := loadbalancers.Get(client, lbID).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving ELBv2 load balancer: %w", err)
+	}
+
+	var warnings []string
+
+	loadbalancer, err := lbTopologyV2LoadBalancer(config, region, client, lb)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	allListeners, warns, err := lbTopologyV2Listeners(client, lbID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	warnings = append(warnings, warns...)
+
+	var listenerList, policyList, ipGroupList []map[string]interface{}
+	for _, listener := range allListeners {
+		ipGroups, ipGroup, err := lbTopologyV2IpGroups(client, listener)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ipGroupList = append(ipGroupList, ipGroups...)
+
+		listenerTags, err := tags.Get(client, "listeners", listener.ID).Extract()
+		if err != nil {
+			return fmterr.Errorf("error fetching ELBv2 listener tags: %w", err)
+		}
+
+		listenerList = append(listenerList, map[string]interface{}{
+			"id":                          listener.ID,
+			"name":                        listener.Name,
+			"description":                 listener.Description,
+			"protocol":                    v3ListenerProtocol(listener.Protocol),
+			"protocol_port":               listener.ProtocolPort,
+			"default_pool_id":             listener.DefaultPoolID,
+			"http2_enable":                listener.Http2Enable,
+			"default_tls_container_ref":   listener.DefaultTlsContainerRef,
+			"client_ca_tls_container_ref": listener.CAContainerRef,
+			"sni_container_refs":          listener.SniContainerRefs,
+			"tls_ciphers_policy":          listener.TlsCiphersPolicy,
+			"admin_state_up":              listener.AdminStateUp,
+			"ip_group":                    ipGroup,
+			"tags":                        common.TagsToMap(listenerTags),
+		})
+
+		policies, warns, err := lbTopologyV2Policies(client, listener.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		policyList = append(policyList, policies...)
+		warnings = append(warnings, warns...)
+	}
+
+	poolList, warns, err := lbTopologyV2Pools(client, lbID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	warnings = append(warnings, warns...)
+
+	log.Printf("[DEBUG] Retrieved ELBv2 load balancer %s topology: %d listeners, %d pools, %d policies",
+		lbID, len(listenerList), len(poolList), len(policyList))
+
+	d.SetId(lbID)
+	mErr := multierror.Append(
+		d.Set("region", region),
+		d.Set("loadbalancer", []map[string]interface{}{loadbalancer}),
+		d.Set("listeners", listenerList),
+		d.Set("pools", poolList),
+		d.Set("policies", policyList),
+		d.Set("ipgroups", ipGroupList),
+		d.Set("warnings", warnings),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func lbTopologyV2LoadBalancer(config *cfg.Config, region string, client *golangsdk.ServiceClient, lb *loadbalancers.LoadBalancer) (map[string]interface{}, error) {
+	// ELB v3 expects the VPC and the VPC subnet (network) IDs instead of the neutron subnet ID only
+	nwV2Client, err := config.NetworkingV2Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+	subnet, err := subnets.Get(nwV2Client, lb.VipSubnetID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving ELBv2 load balancer VIP subnet: %w", err)
+	}
+
+	nwV1Client, err := config.NetworkingV1Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+	}
+	vpcSubnet, err := vpcsubnets.Get(nwV1Client, subnet.NetworkID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving ELBv2 load balancer VPC subnet: %w", err)
+	}
+
+	lbTags, err := tags.Get(client, "loadbalancers", lb.ID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching ELBv2 load balancer tags: %w", err)
+	}
+
+	return map[string]interface{}{
+		"id":             lb.ID,
+		"name":           lb.Name,
+		"description":    lb.Description,
+		"vip_address":    lb.VipAddress,
+		"subnet_id":      lb.VipSubnetID,
+		"network_ids":    []string{subnet.NetworkID},
+		"router_id":      vpcSubnet.VpcID,
+		"admin_state_up": lb.AdminStateUp,
+		"tags":           common.TagsToMap(lbTags),
+	}, nil
+}
+
+func lbTopologyV2Listeners(client *golangsdk.ServiceClient, lbID string) ([]listeners.Listener, []string, error) {
+	pages, err := listeners.List(client, listeners.ListOpts{LoadbalancerID: lbID}).AllPages()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing ELBv2 listeners: %w", err)
+	}
+	allListeners, err := listeners.ExtractListeners(pages)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error extracting ELBv2 listeners: %w", err)
+	}
+
+	var warnings []string
+	for _, listener := range allListeners {
+		if listener.ConnLimit > 0 {
+			warnings = append(warnings, fmt.Sprintf(
+				"listener %s: connection_limit is not supported by ELB v3 and is dropped", listener.ID))
+		}
+		if listener.DefaultTlsContainerRef != "" || listener.CAContainerRef != "" || len(listener.SniContainerRefs) > 0 {
+			warnings = append(warnings, fmt.Sprintf(
+				"listener %s: ELB v2 certificates have to be recreated as ELB v3 certificates", listener.ID))
+		}
+	}
+	return allListeners, warnings, nil
+}
+
+// lbTopologyV2IpGroups converts listener whitelists to the IP groups and
+// returns the `ip_group` block of the listener referencing the whitelist
+func lbTopologyV2IpGroups(client *golangsdk.ServiceClient, listener listeners.Listener) ([]map[string]interface{}, []map[string]interface{}, error) {
+	allWhitelists, err := listListenerWhitelists(client, listener.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing ELBv2 whitelists: %w", err)
+	}
+
+	name := listener.Name
+	if name == "" {
+		name = listener.ID
+	}
+
+	var ipGroup []map[string]interface{}
+	result := make([]map[string]interface{}, 0, len(allWhitelists))
+	for _, wl := range allWhitelists {
+		var ipList []map[string]interface{}
+		for _, ip := range strings.Split(wl.Whitelist, ",") {
+			ip = strings.TrimSpace(ip)
+			if ip == "" {
+				continue
+			}
+			ipList = append(ipList, map[string]interface{}{
+				"ip":          ip,
+				"description": "",
+			})
+		}
+		result = append(result, map[string]interface{}{
+			"id":          wl.ID,
+			"name":        fmt.Sprintf("%s-whitelist", name),
+			"description": fmt.Sprintf("Whitelist of ELB v2 listener %s", listener.ID),
+			"listener_id": listener.ID,
+			"ip_list":     ipList,
+		})
+		ipGroup = []map[string]interface{}{
+			{
+				"id":     wl.ID,
+				"enable": wl.EnableWhitelist,
+				"type":   "white",
+			},
+		}
+	}
+	return result, ipGroup, nil
+}
+
+func lbTopologyV2Policies(client *golangsdk.ServiceClient, listenerID string) ([]map[string]interface{}, []string, error) {
+	pages, err := l7policies.List(client, l7policies.ListOpts{ListenerID: listenerID}).AllPages()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing ELBv2 L7 policies: %w", err)
+	}
+	allPolicies, err := l7policies.ExtractL7Policies(pages)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error extracting ELBv2 L7 policies: %w", err)
+	}
+
+	var warnings []string
+	result := make([]map[string]interface{}, len(allPolicies))
+	for i, policy := range allPolicies {
+		rulePages, err := l7policies.ListRules(client, policy.ID, l7policies.ListRulesOpts{}).AllPages()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error listing ELBv2 L7 rules: %w", err)
+		}
+		allRules, err := l7policies.ExtractRules(rulePages)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error extracting ELBv2 L7 rules: %w", err)
+		}
+
+		rules := make([]map[string]interface{}, len(allRules))
+		for j, rule := range allRules {
+			if rule.Invert {
+				warnings = append(warnings, fmt.Sprintf(
+					"rule %s: invert is not supported by ELB v3 and is dropped", rule.ID))
+			}
+			rules[j] = map[string]interface{}{
+				"id":           rule.ID,
+				"type":         rule.RuleType,
+				"compare_type": rule.CompareType,
+				"value":        rule.Value,
+			}
+		}
+
+		result[i] = map[string]interface{}{
+			"id":                   policy.ID,
+			"name":                 policy.Name,
+			"description":          policy.Description,
+			"listener_id":          policy.ListenerID,
+			"action":               policy.Action,
+			"position":             int(policy.Position),
+			"redirect_pool_id":     policy.RedirectPoolID,
+			"redirect_listener_id": policy.RedirectListenerID,
+			"rules":                rules,
+		}
+	}
+	return result, warnings, nil
+}
+
+func lbTopologyV2Pools(client *golangsdk.ServiceClient, lbID string) ([]map[string]interface{}, []string, error) {
+	pages, err := pools.List(client, pools.ListOpts{LoadbalancerID: lbID}).AllPages()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing ELBv2 pools: %w", err)
+	}
+	allPools, err := pools.ExtractPools(pages)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error extracting ELBv2 pools: %w", err)
+	}
+
+	var warnings []string
+	result := make([]map[string]interface{}, len(allPools))
+	for i, pool := range allPools {
+		memberPages, err := pools.ListMembers(client, pool.ID, pools.ListMembersOpts{}).AllPages()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error listing ELBv2 members: %w", err)
+		}
+		allMembers, err := pools.ExtractMembers(memberPages)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error extracting ELBv2 members: %w", err)
+		}
+
+		members := make([]map[string]interface{}, len(allMembers))
+		for j, member := range allMembers {
+			weight := member.Weight
+			if !member.AdminStateUp {
+				// the member can't be disabled in ELB v3, zero weight has the same effect
+				weight = 0
+				warnings = append(warnings, fmt.Sprintf(
+					"member %s: admin_state_up = false is converted to weight = 0", member.ID))
+			}
+			members[j] = map[string]interface{}{
+				"id":            member.ID,
+				"name":          member.Name,
+				"address":       member.Address,
+				"protocol_port": member.ProtocolPort,
+				"subnet_id":     member.SubnetID,
+				"weight":        weight,
+			}
+		}
+
+		var monitor []map[string]interface{}
+		if pool.MonitorID != "" {
+			m, err := monitors.Get(client, pool.MonitorID).Extract()
+			if err != nil {
+				return nil, nil, fmt.Errorf("error retrieving ELBv2 monitor: %w", err)
+			}
+			monitor = []map[string]interface{}{
+				{
+					"id":             m.ID,
+					"name":           m.Name,
+					"type":           m.Type,
+					"delay":          m.Delay,
+					"timeout":        m.Timeout,
+					"max_retries":    m.MaxRetries,
+					"http_method":    m.HTTPMethod,
+					"url_path":       m.URLPath,
+					"expected_codes": m.ExpectedCodes,
+					"domain_name":    m.DomainName,
+					"monitor_port":   m.MonitorPort,
+				},
+			}
+		}
+
+		var persistence []map[string]interface{}
+		if pool.Persistence.Type != "" {
+			persistence = []map[string]interface{}{
+				{
+					"type":        pool.Persistence.Type,
+					"cookie_name": pool.Persistence.CookieName,
+				},
+			}
+		}
+
+		var listenerID string
+		if len(pool.Listeners) > 0 {
+			listenerID = pool.Listeners[0].ID
+		}
+
+		result[i] = map[string]interface{}{
+			"id":                  pool.ID,
+			"name":                pool.Name,
+			"description":         pool.Description,
+			"protocol":            pool.Protocol,
+			"lb_algorithm":        pool.LBMethod,
+			"listener_id":         listenerID,
+			"session_persistence": persistence,
+			"members":             members,
+			"monitor":             monitor,
+		}
+	}
+	return result, warnings, nil
+}
@@ -1,5 +1,14 @@
 package v3
 
+import (
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/structs"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/members"
+)
+
 const (
 	keyClient       = "lbv3-client"
 	ErrCreateClient = "error creating ELBv3 client: %w"
@@ -9,3 +18,73 @@ func iBool(v interface{}) *bool {
 	b := v.(bool)
 	return &b
 }
+
+// singularDataSourceSchema builds the schema of the singular data source from the computed
+// element schema of the plural one, `filters` become optional arguments
+func singularDataSourceSchema(fields map[string]*schema.Schema, filters ...string) map[string]*schema.Schema {
+	for _, filter := range filters {
+		fields[filter].Optional = true
+	}
+	return fields
+}
+
+// setDataSourceFields sets all flattened fields of the found resource except the `id`
+func setDataSourceFields(d *schema.ResourceData, fields map[string]interface{}) diag.Diagnostics {
+	mErr := &multierror.Error{}
+	for key, value := range fields {
+		if key == "id" {
+			continue
+		}
+		mErr = multierror.Append(mErr, d.Set(key, value))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceRefIDs returns IDs of the referenced resources
+func resourceRefIDs(refs []structs.ResourceRef) []string {
+	ids := make([]string, len(refs))
+	for i, ref := range refs {
+		ids[i] = ref.ID
+	}
+	return ids
+}
+
+type batchMemberOpts struct {
+	// ID is required for the batch update and delete.
+	ID           string  `json:"id,omitempty"`
+	Address      string  `json:"address,omitempty"`
+	ProtocolPort int     `json:"protocol_port,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	Weight       *int    `json:"weight,omitempty"`
+	SubnetID     string  `json:"subnet_cidr_id,omitempty"`
+}
+
+type batchMembersOpts struct {
+	Members []batchMemberOpts `json:"members"`
+}
+
+// batchMembers calls one of the `batch-add`, `batch-update` or `batch-delete` member actions of the pool
+func batchMembers(client *golangsdk.ServiceClient, poolID, action string, opts []batchMemberOpts) ([]members.Member, error) {
+	b, err := golangsdk.BuildRequestBody(batchMembersOpts{Members: opts}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("pools", poolID, "members", action), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201, 202, 204},
+	})
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	if r.Body == nil {
+		return nil, nil
+	}
+
+	var res []members.Member
+	err = r.ExtractIntoSlicePtr(&res, "members")
+	return res, err
+}
@@ -0,0 +1,51 @@
+package v3
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/ipgroups"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceLBIpGroupV3() *schema.Resource {
+	fields := singularDataSourceSchema(lbIpGroupV3Fields(), append(lbIpGroupV3Filters, "id")...)
+	fields["ip_address"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceLBIpGroupV3Read,
+
+		Schema: fields,
+	}
+}
+
+func dataSourceLBIpGroupV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	found, err := listLBIpGroupsV3(client, d, ipgroups.ListOpts{
+		ID: common.StrSlice(d.Get("id")),
+	})
+	if err != nil {
+		return fmterr.Errorf("error listing LB IP groups v3: %w", err)
+	}
+	if len(found) < 1 {
+		return common.DataSourceTooFewDiag
+	}
+	if len(found) > 1 {
+		return common.DataSourceTooManyDiag
+	}
+
+	d.SetId(found[0].ID)
+	return setDataSourceFields(d, flattenLBIpGroupV3(found[0]))
+}
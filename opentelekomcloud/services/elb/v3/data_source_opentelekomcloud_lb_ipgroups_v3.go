@@ -0,0 +1,184 @@
+package v3
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/ipgroups"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+var lbIpGroupV3Filters = []string{"name", "description", "listener_id"}
+
+func DataSourceLBIpGroupsV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBIpGroupsV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ipgroups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: lbIpGroupV3Fields(),
+				},
+			},
+		},
+	}
+}
+
+func lbIpGroupV3Fields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ip_list": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ip": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"listener_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"listener_ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"project_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"updated_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func listLBIpGroupsV3(client *golangsdk.ServiceClient, d *schema.ResourceData, opts ipgroups.ListOpts) ([]ipgroups.IpGroup, error) {
+	opts.Name = common.StrSlice(d.Get("name"))
+	opts.Description = common.StrSlice(d.Get("description"))
+	if v, ok := d.GetOk("ip_address"); ok {
+		opts.IpList = []string{v.(string)}
+	}
+
+	allGroups, err := ipgroups.List(client, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	listenerID := d.Get("listener_id").(string)
+	if listenerID == "" {
+		return allGroups, nil
+	}
+	var result []ipgroups.IpGroup
+	for _, group := range allGroups {
+		if common.StrSliceContains(resourceRefIDs(group.Listeners), listenerID) {
+			result = append(result, group)
+		}
+	}
+	return result, nil
+}
+
+func flattenLBIpGroupV3(group ipgroups.IpGroup) map[string]interface{} {
+	ipList := make([]map[string]interface{}, len(group.IpList))
+	for i, ip := range group.IpList {
+		ipList[i] = map[string]interface{}{
+			"ip":          ip.Ip,
+			"description": ip.Description,
+		}
+	}
+
+	listenerIDs := resourceRefIDs(group.Listeners)
+	var listenerID string
+	if len(listenerIDs) > 0 {
+		listenerID = listenerIDs[0]
+	}
+
+	return map[string]interface{}{
+		"id":           group.ID,
+		"name":         group.Name,
+		"description":  group.Description,
+		"ip_list":      ipList,
+		"listener_id":  listenerID,
+		"listener_ids": listenerIDs,
+		"project_id":   group.ProjectId,
+		"created_at":   group.CreatedAt,
+		"updated_at":   group.UpdatedAt,
+	}
+}
+
+func dataSourceLBIpGroupsV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	allGroups, err := listLBIpGroupsV3(client, d, ipgroups.ListOpts{})
+	if err != nil {
+		return fmterr.Errorf("error listing LB IP groups v3: %w", err)
+	}
+
+	ids := make([]string, len(allGroups))
+	result := make([]map[string]interface{}, len(allGroups))
+	for i, group := range allGroups {
+		ids[i] = group.ID
+		result[i] = flattenLBIpGroupV3(group)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d LB IP groups v3 using given filter", len(ids))
+	d.SetId(hashcode.Strings(ids))
+	if err := d.Set("ipgroups", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
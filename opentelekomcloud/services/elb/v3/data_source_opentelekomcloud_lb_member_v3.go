@@ -0,0 +1,51 @@
+package v3
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/members"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceLBMemberV3() *schema.Resource {
+	fields := singularDataSourceSchema(lbMemberV3Fields(), append(lbMemberV3Filters, "id")...)
+	fields["pool_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceLBMemberV3Read,
+
+		Schema: fields,
+	}
+}
+
+func dataSourceLBMemberV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	found, err := listLBMembersV3(client, d, members.ListOpts{
+		ID: d.Get("id").(string),
+	})
+	if err != nil {
+		return fmterr.Errorf("error listing members of LB pool v3: %w", err)
+	}
+	if len(found) < 1 {
+		return common.DataSourceTooFewDiag
+	}
+	if len(found) > 1 {
+		return common.DataSourceTooManyDiag
+	}
+
+	d.SetId(found[0].ID)
+	return setDataSourceFields(d, flattenLBMemberV3(found[0]))
+}
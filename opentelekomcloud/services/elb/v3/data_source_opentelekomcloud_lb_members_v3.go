@@ -0,0 +1,154 @@
+package v3
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/members"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+var lbMemberV3Filters = []string{"name", "address", "protocol_port", "operating_status"}
+
+func DataSourceLBMembersV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBMembersV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"protocol_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"operating_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: lbMemberV3Fields(),
+				},
+			},
+		},
+	}
+}
+
+func lbMemberV3Fields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"address": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"protocol_port": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"subnet_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"weight": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"operating_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ip_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"admin_state_up": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"project_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func listLBMembersV3(client *golangsdk.ServiceClient, d *schema.ResourceData, opts members.ListOpts) ([]members.Member, error) {
+	opts.Name = d.Get("name").(string)
+	opts.Address = d.Get("address").(string)
+	opts.ProtocolPort = d.Get("protocol_port").(int)
+	opts.OperatingStatus = d.Get("operating_status").(string)
+
+	pages, err := members.List(client, d.Get("pool_id").(string), opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return members.ExtractMembers(pages)
+}
+
+func flattenLBMemberV3(member members.Member) map[string]interface{} {
+	return map[string]interface{}{
+		"id":               member.ID,
+		"name":             member.Name,
+		"address":          member.Address,
+		"protocol_port":    member.ProtocolPort,
+		"subnet_id":        member.SubnetID,
+		"weight":           member.Weight,
+		"operating_status": member.OperatingStatus,
+		"ip_version":       member.IpVersion,
+		"admin_state_up":   member.AdminStateUp,
+		"project_id":       member.ProjectID,
+	}
+}
+
+func dataSourceLBMembersV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	allMembers, err := listLBMembersV3(client, d, members.ListOpts{})
+	if err != nil {
+		return fmterr.Errorf("error listing members of LB pool v3 (%s): %w", poolID, err)
+	}
+
+	ids := make([]string, len(allMembers))
+	result := make([]map[string]interface{}, len(allMembers))
+	for i, member := range allMembers {
+		ids[i] = member.ID
+		result[i] = flattenLBMemberV3(member)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d members of LB pool v3 (%s) using given filter", len(ids), poolID)
+	d.SetId(hashcode.Strings(append([]string{poolID}, ids...)))
+	if err := d.Set("members", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
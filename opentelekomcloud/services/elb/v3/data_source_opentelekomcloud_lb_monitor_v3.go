@@ -0,0 +1,45 @@
+package v3
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/monitors"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceLBMonitorV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBMonitorV3Read,
+
+		Schema: singularDataSourceSchema(lbMonitorV3Fields(), append(lbMonitorV3Filters, "id")...),
+	}
+}
+
+func dataSourceLBMonitorV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	found, err := listLBMonitorsV3(client, d, monitors.ListOpts{
+		ID: d.Get("id").(string),
+	})
+	if err != nil {
+		return fmterr.Errorf("error listing LB monitors v3: %w", err)
+	}
+	if len(found) < 1 {
+		return common.DataSourceTooFewDiag
+	}
+	if len(found) > 1 {
+		return common.DataSourceTooManyDiag
+	}
+
+	d.SetId(found[0].ID)
+	return setDataSourceFields(d, flattenLBMonitorV3(found[0]))
+}
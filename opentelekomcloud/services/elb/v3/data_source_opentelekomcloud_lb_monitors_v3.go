@@ -0,0 +1,174 @@
+package v3
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/monitors"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+var lbMonitorV3Filters = []string{"name", "pool_id", "type"}
+
+func DataSourceLBMonitorsV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBMonitorsV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"monitors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: lbMonitorV3Fields(),
+				},
+			},
+		},
+	}
+}
+
+func lbMonitorV3Fields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"pool_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"delay": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"timeout": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"max_retries": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"max_retries_down": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"http_method": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"url_path": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"domain_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"expected_codes": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"monitor_port": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"admin_state_up": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"project_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func listLBMonitorsV3(client *golangsdk.ServiceClient, d *schema.ResourceData, opts monitors.ListOpts) ([]monitors.Monitor, error) {
+	opts.Name = d.Get("name").(string)
+	opts.PoolID = d.Get("pool_id").(string)
+	opts.Type = d.Get("type").(string)
+
+	pages, err := monitors.List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return monitors.ExtractMonitors(pages)
+}
+
+func flattenLBMonitorV3(monitor monitors.Monitor) map[string]interface{} {
+	var poolID string
+	if len(monitor.Pools) > 0 {
+		poolID = monitor.Pools[0].ID
+	}
+
+	return map[string]interface{}{
+		"id":               monitor.ID,
+		"name":             monitor.Name,
+		"pool_id":          poolID,
+		"type":             string(monitor.Type),
+		"delay":            monitor.Delay,
+		"timeout":          monitor.Timeout,
+		"max_retries":      monitor.MaxRetries,
+		"max_retries_down": monitor.MaxRetriesDown,
+		"http_method":      monitor.HTTPMethod,
+		"url_path":         monitor.URLPath,
+		"domain_name":      monitor.DomainName,
+		"expected_codes":   monitor.ExpectedCodes,
+		"monitor_port":     monitor.MonitorPort,
+		"admin_state_up":   monitor.AdminStateUp,
+		"project_id":       monitor.ProjectID,
+	}
+}
+
+func dataSourceLBMonitorsV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	allMonitors, err := listLBMonitorsV3(client, d, monitors.ListOpts{})
+	if err != nil {
+		return fmterr.Errorf("error listing LB monitors v3: %w", err)
+	}
+
+	ids := make([]string, len(allMonitors))
+	result := make([]map[string]interface{}, len(allMonitors))
+	for i, monitor := range allMonitors {
+		ids[i] = monitor.ID
+		result[i] = flattenLBMonitorV3(monitor)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d LB monitors v3 using given filter", len(ids))
+	d.SetId(hashcode.Strings(ids))
+	if err := d.Set("monitors", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+package v3
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/policies"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+var lbPolicyV3Filters = []string{"name", "listener_id", "action", "redirect_pool_id", "redirect_listener_id"}
+
+func DataSourceLBPoliciesV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBPoliciesV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"redirect_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"redirect_listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: lbPolicyV3Fields(),
+				},
+			},
+		},
+	}
+}
+
+func lbPolicyV3Fields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"listener_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"action": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"position": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"priority": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"redirect_pool_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"redirect_listener_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"redirect_url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"rule_ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"fixed_response_config": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"status_code": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"content_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"message_body": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"redirect_url_config": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"status_code": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"protocol": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"host": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"port": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"path": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"query": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"redirect_pools_config": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"pool_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"weight": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"project_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func listLBPoliciesV3(client *golangsdk.ServiceClient, d *schema.ResourceData, opts policies.ListOpts) ([]policies.Policy, error) {
+	opts.Name = common.StrSlice(d.Get("name"))
+	opts.ListenerID = common.StrSlice(d.Get("listener_id"))
+	opts.Action = common.StrSlice(d.Get("action"))
+	opts.RedirectPoolID = common.StrSlice(d.Get("redirect_pool_id"))
+	opts.RedirectListenerID = common.StrSlice(d.Get("redirect_listener_id"))
+
+	pages, err := policies.List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return policies.ExtractPolicies(pages)
+}
+
+func flattenLBPolicyV3(policy policies.Policy) map[string]interface{} {
+	var fixedResponseConfig []map[string]interface{}
+	if policy.FixedResponseConfig.StatusCode != "" {
+		fixedResponseConfig = append(fixedResponseConfig, map[string]interface{}{
+			"status_code":  policy.FixedResponseConfig.StatusCode,
+			"content_type": policy.FixedResponseConfig.ContentType,
+			"message_body": policy.FixedResponseConfig.MessageBody,
+		})
+	}
+
+	var redirectUrlConfig []map[string]interface{}
+	if policy.RedirectUrlConfig.StatusCode != "" {
+		redirectUrlConfig = append(redirectUrlConfig, map[string]interface{}{
+			"status_code": policy.RedirectUrlConfig.StatusCode,
+			"protocol":    policy.RedirectUrlConfig.Protocol,
+			"host":        policy.RedirectUrlConfig.Host,
+			"port":        policy.RedirectUrlConfig.Port,
+			"path":        policy.RedirectUrlConfig.Path,
+			"query":       policy.RedirectUrlConfig.Query,
+		})
+	}
+
+	redirectPoolsConfig := make([]map[string]interface{}, len(policy.RedirectPoolsConfig))
+	for i, v := range policy.RedirectPoolsConfig {
+		weight, _ := strconv.Atoi(v.Weight)
+		redirectPoolsConfig[i] = map[string]interface{}{
+			"pool_id": v.PoolId,
+			"weight":  weight,
+		}
+	}
+
+	return map[string]interface{}{
+		"id":                    policy.ID,
+		"name":                  policy.Name,
+		"description":           policy.Description,
+		"listener_id":           policy.ListenerID,
+		"action":                string(policy.Action),
+		"position":              policy.Position,
+		"priority":              policy.Priority,
+		"redirect_pool_id":      policy.RedirectPoolID,
+		"redirect_listener_id":  policy.RedirectListenerID,
+		"redirect_url":          policy.RedirectUrl,
+		"rule_ids":              resourceRefIDs(policy.Rules),
+		"fixed_response_config": fixedResponseConfig,
+		"redirect_url_config":   redirectUrlConfig,
+		"redirect_pools_config": redirectPoolsConfig,
+		"status":                policy.Status,
+		"project_id":            policy.ProjectID,
+	}
+}
+
+func dataSourceLBPoliciesV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	allPolicies, err := listLBPoliciesV3(client, d, policies.ListOpts{})
+	if err != nil {
+		return fmterr.Errorf("error listing LB policies v3: %w", err)
+	}
+
+	ids := make([]string, len(allPolicies))
+	result := make([]map[string]interface{}, len(allPolicies))
+	for i, policy := range allPolicies {
+		ids[i] = policy.ID
+		result[i] = flattenLBPolicyV3(policy)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d LB policies v3 using given filter", len(ids))
+	d.SetId(hashcode.Strings(ids))
+	if err := d.Set("policies", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
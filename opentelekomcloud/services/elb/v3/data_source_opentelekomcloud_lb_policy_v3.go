@@ -0,0 +1,45 @@
+package v3
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/policies"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceLBPolicyV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBPolicyV3Read,
+
+		Schema: singularDataSourceSchema(lbPolicyV3Fields(), append(lbPolicyV3Filters, "id")...),
+	}
+}
+
+func dataSourceLBPolicyV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	found, err := listLBPoliciesV3(client, d, policies.ListOpts{
+		ID: common.StrSlice(d.Get("id")),
+	})
+	if err != nil {
+		return fmterr.Errorf("error listing LB policies v3: %w", err)
+	}
+	if len(found) < 1 {
+		return common.DataSourceTooFewDiag
+	}
+	if len(found) > 1 {
+		return common.DataSourceTooManyDiag
+	}
+
+	d.SetId(found[0].ID)
+	return setDataSourceFields(d, flattenLBPolicyV3(found[0]))
+}
@@ -0,0 +1,263 @@
+package v3
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/pools"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+var lbPoolV3Filters = []string{"name", "loadbalancer_id", "listener_id", "protocol", "lb_algorithm", "healthmonitor_id"}
+
+func DataSourceLBPoolsV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBPoolsV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"lb_algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"healthmonitor_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"pools": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: lbPoolV3Fields(),
+				},
+			},
+		},
+	}
+}
+
+func lbPoolV3Fields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"protocol": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"lb_algorithm": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"loadbalancer_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"listener_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"healthmonitor_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"member_ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"session_persistence": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"cookie_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"persistence_timeout": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"slow_start": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enable": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"duration": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"ip_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"vpc_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"member_deletion_protection_enable": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"admin_state_up": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"project_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func listLBPoolsV3(client *golangsdk.ServiceClient, d *schema.ResourceData, opts pools.ListOpts) ([]pools.Pool, error) {
+	opts.Name = common.StrSlice(d.Get("name"))
+	opts.LoadbalancerID = common.StrSlice(d.Get("loadbalancer_id"))
+	opts.Protocol = common.StrSlice(d.Get("protocol"))
+	opts.LBMethod = common.StrSlice(d.Get("lb_algorithm"))
+	opts.HealthMonitorID = common.StrSlice(d.Get("healthmonitor_id"))
+
+	pages, err := pools.List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allPools, err := pools.ExtractPools(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	listenerID := d.Get("listener_id").(string)
+	if listenerID == "" {
+		return allPools, nil
+	}
+	var result []pools.Pool
+	for _, pool := range allPools {
+		if common.StrSliceContains(resourceRefIDs(pool.Listeners), listenerID) {
+			result = append(result, pool)
+		}
+	}
+	return result, nil
+}
+
+func flattenLBPoolV3(pool pools.Pool) map[string]interface{} {
+	var loadbalancerID, listenerID string
+	if len(pool.Loadbalancers) > 0 {
+		loadbalancerID = pool.Loadbalancers[0].ID
+	}
+	if len(pool.Listeners) > 0 {
+		listenerID = pool.Listeners[0].ID
+	}
+
+	var persistence []map[string]interface{}
+	if pool.Persistence != nil && pool.Persistence.Type != "" {
+		persistence = append(persistence, map[string]interface{}{
+			"type":                pool.Persistence.Type,
+			"cookie_name":         pool.Persistence.CookieName,
+			"persistence_timeout": pool.Persistence.PersistenceTimeout,
+		})
+	}
+
+	var slowStart []map[string]interface{}
+	if pool.SlowStart != nil {
+		slowStart = append(slowStart, map[string]interface{}{
+			"enable":   pool.SlowStart.Enable,
+			"duration": pool.SlowStart.Duration,
+		})
+	}
+
+	return map[string]interface{}{
+		"id":                                pool.ID,
+		"name":                              pool.Name,
+		"description":                       pool.Description,
+		"protocol":                          pool.Protocol,
+		"lb_algorithm":                      pool.LBMethod,
+		"loadbalancer_id":                   loadbalancerID,
+		"listener_id":                       listenerID,
+		"healthmonitor_id":                  pool.MonitorID,
+		"member_ids":                        resourceRefIDs(pool.Members),
+		"session_persistence":               persistence,
+		"slow_start":                        slowStart,
+		"ip_version":                        pool.IpVersion,
+		"vpc_id":                            pool.VpcId,
+		"type":                              pool.Type,
+		"member_deletion_protection_enable": pool.DeletionProtectionEnable,
+		"admin_state_up":                    pool.AdminStateUp,
+		"project_id":                        pool.ProjectID,
+	}
+}
+
+func dataSourceLBPoolsV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	allPools, err := listLBPoolsV3(client, d, pools.ListOpts{})
+	if err != nil {
+		return fmterr.Errorf("error listing LB pools v3: %w", err)
+	}
+
+	ids := make([]string, len(allPools))
+	result := make([]map[string]interface{}, len(allPools))
+	for i, pool := range allPools {
+		ids[i] = pool.ID
+		result[i] = flattenLBPoolV3(pool)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d LB pools v3 using given filter", len(ids))
+	d.SetId(hashcode.Strings(ids))
+	if err := d.Set("pools", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
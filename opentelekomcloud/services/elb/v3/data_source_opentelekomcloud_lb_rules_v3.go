@@ -0,0 +1,165 @@
+package v3
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/rules"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+var lbRuleV3Filters = []string{"type", "compare_type", "value"}
+
+func DataSourceLBRulesV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBRulesV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"compare_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: lbRuleV3Fields(),
+				},
+			},
+		},
+	}
+}
+
+func lbRuleV3Fields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"compare_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"value": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"conditions": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"value": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"project_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// ruleListOpts implements rules.ListOptsBuilder missing for rules.ListOpts
+type ruleListOpts rules.ListOpts
+
+func (opts ruleListOpts) ToRuleListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+func listLBRulesV3(client *golangsdk.ServiceClient, d *schema.ResourceData, opts rules.ListOpts) ([]rules.ForwardingRule, error) {
+	if v := d.Get("type").(string); v != "" {
+		opts.Type = []rules.RuleType{rules.RuleType(v)}
+	}
+	if v := d.Get("compare_type").(string); v != "" {
+		opts.CompareType = []rules.CompareType{rules.CompareType(v)}
+	}
+	opts.Value = common.StrSlice(d.Get("value"))
+
+	pages, err := rules.List(client, d.Get("policy_id").(string), ruleListOpts(opts)).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return rules.ExtractRules(pages)
+}
+
+func flattenLBRuleV3(rule rules.ForwardingRule) map[string]interface{} {
+	conditions := make([]map[string]interface{}, len(rule.Conditions))
+	for i, condition := range rule.Conditions {
+		conditions[i] = map[string]interface{}{
+			"key":   condition.Key,
+			"value": condition.Value,
+		}
+	}
+
+	return map[string]interface{}{
+		"id":           rule.ID,
+		"type":         string(rule.Type),
+		"compare_type": string(rule.CompareType),
+		"value":        rule.Value,
+		"conditions":   conditions,
+		"project_id":   rule.ProjectID,
+	}
+}
+
+func dataSourceLBRulesV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	policyID := d.Get("policy_id").(string)
+	allRules, err := listLBRulesV3(client, d, rules.ListOpts{})
+	if err != nil {
+		return fmterr.Errorf("error listing rules of LB policy v3 (%s): %w", policyID, err)
+	}
+
+	ids := make([]string, len(allRules))
+	result := make([]map[string]interface{}, len(allRules))
+	for i, rule := range allRules {
+		ids[i] = rule.ID
+		result[i] = flattenLBRuleV3(rule)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d rules of LB policy v3 (%s) using given filter", len(ids), policyID)
+	d.SetId(hashcode.Strings(append([]string{policyID}, ids...)))
+	if err := d.Set("rules", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package v3
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/security_policy"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+var lbSecurityPolicyV3Filters = []string{"name", "description", "listener_id"}
+
+func DataSourceLBSecurityPoliciesV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBSecurityPoliciesV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cipher": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"security_policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: lbSecurityPolicyV3Fields(),
+				},
+			},
+		},
+	}
+}
+
+func lbSecurityPolicyV3Fields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"protocols": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"ciphers": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"listener_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"listener_ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"project_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"updated_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func listLBSecurityPoliciesV3(client *golangsdk.ServiceClient, d *schema.ResourceData, opts security_policy.ListOpts) ([]security_policy.PolicyRef, error) {
+	opts.Name = common.StrSlice(d.Get("name"))
+	opts.Description = common.StrSlice(d.Get("description"))
+	if v, ok := d.GetOk("protocol"); ok {
+		opts.Protocols = []string{v.(string)}
+	}
+	if v, ok := d.GetOk("cipher"); ok {
+		opts.Ciphers = []string{v.(string)}
+	}
+
+	allPolicies, err := security_policy.List(client, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	listenerID := d.Get("listener_id").(string)
+	if listenerID == "" {
+		return allPolicies, nil
+	}
+	var result []security_policy.PolicyRef
+	for _, policy := range allPolicies {
+		for _, listener := range policy.Listeners {
+			if listener.ID == listenerID {
+				result = append(result, policy)
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+func flattenLBSecurityPolicyV3(policy security_policy.PolicyRef) map[string]interface{} {
+	listenerIDs := make([]string, len(policy.Listeners))
+	for i, listener := range policy.Listeners {
+		listenerIDs[i] = listener.ID
+	}
+	var listenerID string
+	if len(listenerIDs) > 0 {
+		listenerID = listenerIDs[0]
+	}
+
+	return map[string]interface{}{
+		"id":           policy.ID,
+		"name":         policy.Name,
+		"description":  policy.Description,
+		"protocols":    policy.Protocols,
+		"ciphers":      policy.Ciphers,
+		"listener_id":  listenerID,
+		"listener_ids": listenerIDs,
+		"project_id":   policy.ProjectId,
+		"created_at":   policy.CreatedAt,
+		"updated_at":   policy.UpdatedAt,
+	}
+}
+
+func dataSourceLBSecurityPoliciesV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	allPolicies, err := listLBSecurityPoliciesV3(client, d, security_policy.ListOpts{})
+	if err != nil {
+		return fmterr.Errorf("error listing LB security policies v3: %w", err)
+	}
+
+	ids := make([]string, len(allPolicies))
+	result := make([]map[string]interface{}, len(allPolicies))
+	for i, policy := range allPolicies {
+		ids[i] = policy.ID
+		result[i] = flattenLBSecurityPolicyV3(policy)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d LB security policies v3 using given filter", len(ids))
+	d.SetId(hashcode.Strings(ids))
+	if err := d.Set("security_policies", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package v3
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/security_policy"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceLBSecurityPolicyV3() *schema.Resource {
+	fields := singularDataSourceSchema(lbSecurityPolicyV3Fields(), append(lbSecurityPolicyV3Filters, "id")...)
+	fields["protocol"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	fields["cipher"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceLBSecurityPolicyV3Read,
+
+		Schema: fields,
+	}
+}
+
+func dataSourceLBSecurityPolicyV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	found, err := listLBSecurityPoliciesV3(client, d, security_policy.ListOpts{
+		ID: common.StrSlice(d.Get("id")),
+	})
+	if err != nil {
+		return fmterr.Errorf("error listing LB security policies v3: %w", err)
+	}
+	if len(found) < 1 {
+		return common.DataSourceTooFewDiag
+	}
+	if len(found) > 1 {
+		return common.DataSourceTooManyDiag
+	}
+
+	d.SetId(found[0].ID)
+	return setDataSourceFields(d, flattenLBSecurityPolicyV3(found[0]))
+}
@@ -0,0 +1,375 @@
+package v3
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/pointerto"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/elb/v3/members"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func ResourceLBMembersV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLBMembersV3Create,
+		ReadContext:   resourceLBMembersV3Read,
+		UpdateContext: resourceLBMembersV3Update,
+		DeleteContext: resourceLBMembersV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"members": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      resourceLBMembersV3Hash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"protocol_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operating_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"wait_for_healthy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      100,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"timeout": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "10m",
+							ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+								if _, err := time.ParseDuration(v.(string)); err != nil {
+									errs = append(errs, fmt.Errorf("%q must be a valid duration, e.g. `30s` or `5m`: %s", k, err))
+								}
+								return
+							},
+						},
+					},
+				},
+			},
+			"online_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceLBMembersV3Hash hashes only configurable member fields
+func resourceLBMembersV3Hash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-%d-%s-%s-%d",
+		m["address"], m["protocol_port"], m["subnet_id"], m["name"], m["weight"]))
+	return hashcode.String(buf.String())
+}
+
+// memberKey identifies the backend server in the pool
+func memberKey(address string, port int) string {
+	return fmt.Sprintf("%s:%d", address, port)
+}
+
+func membersByKey(set *schema.Set) map[string]map[string]interface{} {
+	result := make(map[string]map[string]interface{}, set.Len())
+	for _, raw := range set.List() {
+		m := raw.(map[string]interface{})
+		result[memberKey(m["address"].(string), m["protocol_port"].(int))] = m
+	}
+	return result
+}
+
+func addMemberOpts(m map[string]interface{}) batchMemberOpts {
+	return batchMemberOpts{
+		Address:      m["address"].(string),
+		ProtocolPort: m["protocol_port"].(int),
+		Name:         pointerto.String(m["name"].(string)),
+		Weight:       pointerto.Int(m["weight"].(int)),
+		SubnetID:     m["subnet_id"].(string),
+	}
+}
+
+func resourceLBMembersV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClient, func() (*golangsdk.ServiceClient, error) {
+		return config.ElbV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	var opts []batchMemberOpts
+	for _, raw := range d.Get("members").(*schema.Set).List() {
+		opts = append(opts, addMemberOpts(raw.(map[string]interface{})))
+	}
+
+	log.Printf("[DEBUG] Adding %d members to the LB pool %s", len(opts), poolID)
+	if _, err := batchMembers(client, poolID, "batch-add", opts); err != nil {
+		return fmterr.Errorf("error adding members to LB pool v3: %w", err)
+	}
+	d.SetId(poolID)
+
+	if err := waitForLBMembersHealthy(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClient)
+	return resourceLBMembersV3Read(clientCtx, d, meta)
+}
+
+func listLBMembers(client *golangsdk.ServiceClient, poolID string) ([]members.Member, error) {
+	pages, err := members.List(client, poolID, members.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return members.ExtractMembers(pages)
+}
+
+func resourceLBMembersV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClient, func() (*golangsdk.ServiceClient, error) {
+		return config.ElbV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	poolMembers, err := listLBMembers(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error reading LB pool v3 members")
+	}
+
+	result := make([]interface{}, len(poolMembers))
+	online := 0
+	for i, member := range poolMembers {
+		result[i] = map[string]interface{}{
+			"id":               member.ID,
+			"address":          member.Address,
+			"protocol_port":    member.ProtocolPort,
+			"name":             member.Name,
+			"subnet_id":        member.SubnetID,
+			"weight":           member.Weight,
+			"operating_status": member.OperatingStatus,
+			"ip_version":       member.IpVersion,
+		}
+		if member.OperatingStatus == "ONLINE" {
+			online++
+		}
+	}
+
+	if err := d.Set("pool_id", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("members", schema.NewSet(resourceLBMembersV3Hash, result)); err != nil {
+		return fmterr.Errorf("error setting LB pool members: %w", err)
+	}
+	if err := d.Set("online_count", online); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceLBMembersV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClient, func() (*golangsdk.ServiceClient, error) {
+		return config.ElbV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	if d.HasChange("members") {
+		oldRaw, newRaw := d.GetChange("members")
+		oldMembers := membersByKey(oldRaw.(*schema.Set))
+		newMembers := membersByKey(newRaw.(*schema.Set))
+
+		var toDelete, toAdd, toUpdate []batchMemberOpts
+		for key, old := range oldMembers {
+			m, ok := newMembers[key]
+			switch {
+			case !ok || m["subnet_id"] != old["subnet_id"]:
+				toDelete = append(toDelete, batchMemberOpts{ID: old["id"].(string)})
+			case m["name"] != old["name"] || m["weight"] != old["weight"]:
+				toUpdate = append(toUpdate, batchMemberOpts{
+					ID:     old["id"].(string),
+					Name:   pointerto.String(m["name"].(string)),
+					Weight: pointerto.Int(m["weight"].(int)),
+				})
+			}
+		}
+		for key, m := range newMembers {
+			if old, ok := oldMembers[key]; !ok || m["subnet_id"] != old["subnet_id"] {
+				toAdd = append(toAdd, addMemberOpts(m))
+			}
+		}
+
+		// members are deleted first, so that the same address and port can be added back with another subnet
+		if len(toDelete) > 0 {
+			log.Printf("[DEBUG] Removing %d members from the LB pool %s", len(toDelete), d.Id())
+			if _, err := batchMembers(client, d.Id(), "batch-delete", toDelete); err != nil {
+				return fmterr.Errorf("error removing members from LB pool v3: %w", err)
+			}
+		}
+		if len(toUpdate) > 0 {
+			log.Printf("[DEBUG] Updating %d members of the LB pool %s", len(toUpdate), d.Id())
+			if _, err := batchMembers(client, d.Id(), "batch-update", toUpdate); err != nil {
+				return fmterr.Errorf("error updating members of LB pool v3: %w", err)
+			}
+		}
+		if len(toAdd) > 0 {
+			log.Printf("[DEBUG] Adding %d members to the LB pool %s", len(toAdd), d.Id())
+			if _, err := batchMembers(client, d.Id(), "batch-add", toAdd); err != nil {
+				return fmterr.Errorf("error adding members to LB pool v3: %w", err)
+			}
+		}
+	}
+
+	if d.HasChanges("members", "wait_for_healthy") {
+		if err := waitForLBMembersHealthy(ctx, d, client); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClient)
+	return resourceLBMembersV3Read(clientCtx, d, meta)
+}
+
+func resourceLBMembersV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClient, func() (*golangsdk.ServiceClient, error) {
+		return config.ElbV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(ErrCreateClient, err)
+	}
+
+	var opts []batchMemberOpts
+	for _, raw := range d.Get("members").(*schema.Set).List() {
+		if id := raw.(map[string]interface{})["id"].(string); id != "" {
+			opts = append(opts, batchMemberOpts{ID: id})
+		}
+	}
+	if len(opts) == 0 {
+		return nil
+	}
+
+	if _, err := batchMembers(client, d.Id(), "batch-delete", opts); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting LB pool v3 members")
+	}
+
+	return nil
+}
+
+// waitForLBMembersHealthy waits until the configured share of the pool members is `ONLINE`
+func waitForLBMembersHealthy(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	waitRaw := d.Get("wait_for_healthy").([]interface{})
+	if len(waitRaw) == 0 || waitRaw[0] == nil {
+		return nil
+	}
+	wait := waitRaw[0].(map[string]interface{})
+	timeout, _ := time.ParseDuration(wait["timeout"].(string))
+	threshold := wait["threshold"].(int)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"HEALTHY"},
+		Refresh:      lbMembersHealthRefreshFunc(client, d.Id(), threshold),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for LB pool v3 (%s) members to become healthy: %w", d.Id(), err)
+	}
+	return nil
+}
+
+func lbMembersHealthRefreshFunc(client *golangsdk.ServiceClient, poolID string, threshold int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		poolMembers, err := listLBMembers(client, poolID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		online := 0
+		for _, member := range poolMembers {
+			switch member.OperatingStatus {
+			case "ONLINE":
+				online++
+			case "NO_MONITOR":
+				return nil, "", fmt.Errorf("member (%s) has no health check, configure the monitor for the pool", member.ID)
+			}
+		}
+
+		required := int(math.Ceil(float64(len(poolMembers)*threshold) / 100))
+		log.Printf("[DEBUG] %d of %d members of the LB pool %s are online, %d required", online, len(poolMembers), poolID, required)
+		if online >= required {
+			return poolMembers, "HEALTHY", nil
+		}
+		return poolMembers, "PENDING", nil
+	}
+}
@@ -1,6 +1,159 @@
 package er
 
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+)
+
 const (
 	errCreationV3Client = "error creating OpenTelekomCloud EnterpriseRouter v3 client: %w"
 	erClientV3          = "er-v3-client"
+
+	attachmentsPageSize = 1000
 )
+
+// attachment is the common representation of ER attachments of all types
+type attachment struct {
+	ID           string             `json:"id"`
+	Name         string             `json:"name"`
+	Description  string             `json:"description"`
+	State        string             `json:"state"`
+	ResourceID   string             `json:"resource_id"`
+	ResourceType string             `json:"resource_type"`
+	RouteTableID string             `json:"route_table_id"`
+	Associated   bool               `json:"associated"`
+	ProjectID    string             `json:"project_id"`
+	CreatedAt    string             `json:"created_at"`
+	UpdatedAt    string             `json:"updated_at"`
+	Tags         []tags.ResourceTag `json:"tags"`
+}
+
+type attachmentListOpts struct {
+	ID           string `q:"id"`
+	State        string `q:"state"`
+	ResourceID   string `q:"resource_id"`
+	ResourceType string `q:"resource_type"`
+	Limit        int    `q:"limit"`
+	Marker       string `q:"marker"`
+}
+
+// listAttachments returns all attachments of the ER instance matching the given filter
+func listAttachments(client *golangsdk.ServiceClient, instanceID string, opts attachmentListOpts) ([]attachment, error) {
+	opts.Limit = attachmentsPageSize
+
+	var result []attachment
+	for {
+		url, err := golangsdk.NewURLBuilder().
+			WithEndpoints("enterprise-router", instanceID, "attachments").
+			WithQueryParams(&opts).Build()
+		if err != nil {
+			return nil, err
+		}
+
+		var r golangsdk.Result
+		_, r.Err = client.Get(client.ServiceURL(url.String()), &r.Body, nil)
+		if r.Err != nil {
+			return nil, r.Err
+		}
+
+		var page struct {
+			Attachments []attachment `json:"attachments"`
+			PageInfo    struct {
+				NextMarker string `json:"next_marker"`
+			} `json:"page_info"`
+		}
+		if err := r.ExtractInto(&page); err != nil {
+			return nil, err
+		}
+		result = append(result, page.Attachments...)
+
+		if page.PageInfo.NextMarker == "" || len(page.Attachments) == 0 {
+			return result, nil
+		}
+		opts.Marker = page.PageInfo.NextMarker
+	}
+}
+
+// getAttachment returns the details of the attachment of any type
+func getAttachment(client *golangsdk.ServiceClient, instanceID, attachmentID string) (*attachment, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("enterprise-router", instanceID, "attachments", attachmentID), &r.Body, nil)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res attachment
+	if err := r.ExtractIntoStructPtr(&res, "attachment"); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+type attachmentUpdateOpts struct {
+	Name        string  `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// updateTypedAttachment updates the attachment using the type-specific API,
+// e.g. `vpn-attachments` or `dc-attachments`
+func updateTypedAttachment(client *golangsdk.ServiceClient, instanceID, attachmentType, attachmentID string,
+	opts attachmentUpdateOpts) error {
+	bodyKey := fmt.Sprintf("%s_attachment", attachmentType)
+	b, err := golangsdk.BuildRequestBody(opts, bodyKey)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("enterprise-router", instanceID, attachmentType+"-attachments", attachmentID), b, nil,
+		&golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+	return err
+}
+
+func attachmentStatusRefreshFunc(client *golangsdk.ServiceClient, instanceID, attachmentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := getAttachment(client, instanceID, attachmentID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if common.StrSliceContains([]string{"failed"}, resp.State) {
+			return resp, "", fmt.Errorf("unexpected status '%s'", resp.State)
+		}
+		if resp.State == "available" {
+			return resp, "COMPLETED", nil
+		}
+		return resp, "PENDING", nil
+	}
+}
+
+// attachmentByResourceRefreshFunc waits for the attachment of the given type to be created for the resource,
+// e.g. for the VPN connection or DC virtual gateway
+func attachmentByResourceRefreshFunc(client *golangsdk.ServiceClient, instanceID, resourceType, resourceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		attachments, err := listAttachments(client, instanceID, attachmentListOpts{
+			ResourceType: resourceType,
+			ResourceID:   resourceID,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		if len(attachments) == 0 {
+			return attachments, "PENDING", nil
+		}
+
+		resp := attachments[0]
+		if common.StrSliceContains([]string{"failed"}, resp.State) {
+			return resp, "", fmt.Errorf("unexpected status '%s'", resp.State)
+		}
+		if resp.State == "available" {
+			return resp, "COMPLETED", nil
+		}
+		return resp, "PENDING", nil
+	}
+}
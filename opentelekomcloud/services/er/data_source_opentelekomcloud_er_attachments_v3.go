@@ -0,0 +1,170 @@
+package er
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceErAttachmentsV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceErAttachmentsV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"attachment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"vpc", "vpn", "vgw", "peering", "can", "enc", "cfw",
+				}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": common.TagsSchema(),
+			"attachments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"associated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceErAttachmentsV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ErV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	allAttachments, err := listAttachments(client, instanceId, attachmentListOpts{
+		ID:           d.Get("attachment_id").(string),
+		State:        d.Get("status").(string),
+		ResourceID:   d.Get("resource_id").(string),
+		ResourceType: d.Get("type").(string),
+	})
+	if err != nil {
+		return fmterr.Errorf("error querying attachments of the ER instance (%s): %w", instanceId, err)
+	}
+
+	name := d.Get("name").(string)
+	expectedTags := common.ExpandResourceTags(d.Get("tags").(map[string]interface{}))
+
+	var ids []string
+	var result []map[string]interface{}
+	for _, a := range allAttachments {
+		if name != "" && a.Name != name {
+			continue
+		}
+		if !common.ContainsAllTags(a.Tags, expectedTags) {
+			continue
+		}
+
+		ids = append(ids, a.ID)
+		result = append(result, map[string]interface{}{
+			"id":             a.ID,
+			"name":           a.Name,
+			"description":    a.Description,
+			"type":           a.ResourceType,
+			"resource_id":    a.ResourceID,
+			"route_table_id": a.RouteTableID,
+			"associated":     a.Associated,
+			"status":         a.State,
+			"tags":           common.TagsToMap(a.Tags),
+			"created_at":     a.CreatedAt,
+			"updated_at":     a.UpdatedAt,
+		})
+	}
+
+	log.Printf("[DEBUG] Retrieved %d ER attachments using given filter", len(ids))
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("attachments", result),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package er
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/er/v3/route"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceErEffectiveRoutesV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceErEffectiveRoutesV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"destination": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"routes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"route_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_blackhole": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"next_hops": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attachment_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"resource_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"resource_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceErEffectiveRoutesV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ErV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	routeTableId := d.Get("route_table_id").(string)
+	opts := route.ListOpts{
+		RouteTableId: routeTableId,
+		Limit:        1000,
+	}
+	if v, ok := d.GetOk("destination"); ok {
+		opts.Destination = []string{v.(string)}
+	}
+	if v, ok := d.GetOk("resource_type"); ok {
+		opts.ResourceType = []string{v.(string)}
+	}
+
+	var allRoutes []route.EffectiveRoute
+	for {
+		resp, err := route.List(client, opts)
+		if err != nil {
+			return fmterr.Errorf("error querying effective routes of the route table (%s): %w", routeTableId, err)
+		}
+		allRoutes = append(allRoutes, resp.Routes...)
+		if resp.PageInfo == nil || resp.PageInfo.NextMarker == "" || len(resp.Routes) == 0 {
+			break
+		}
+		opts.Marker = resp.PageInfo.NextMarker
+	}
+
+	ids := make([]string, len(allRoutes))
+	result := make([]map[string]interface{}, len(allRoutes))
+	for i, r := range allRoutes {
+		nextHops := make([]map[string]interface{}, len(r.NextHops))
+		for j, hop := range r.NextHops {
+			nextHops[j] = map[string]interface{}{
+				"attachment_id": hop.AttachmentId,
+				"resource_id":   hop.ResourceId,
+				"resource_type": hop.ResourceType,
+			}
+		}
+
+		ids[i] = r.RouteId
+		result[i] = map[string]interface{}{
+			"route_id":     r.RouteId,
+			"destination":  r.Destination,
+			"type":         r.RouteType,
+			"is_blackhole": r.IsBlackhole,
+			"next_hops":    nextHops,
+		}
+	}
+
+	log.Printf("[DEBUG] Retrieved %d effective routes of the route table (%s)", len(ids), routeTableId)
+	d.SetId(hashcode.Strings(append([]string{routeTableId}, ids...)))
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("routes", result),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package er
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/er/v3/instance"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceErInstancesV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceErInstancesV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": common.TagsSchema(),
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"asn": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"enable_default_propagation": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enable_default_association": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"auto_accept_shared_attachments": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"default_propagation_route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_association_route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceErInstancesV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ErV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	opts := instance.ListOpts{}
+	if v, ok := d.GetOk("instance_id"); ok {
+		opts.ID = []string{v.(string)}
+	}
+	if v, ok := d.GetOk("status"); ok {
+		opts.State = []string{v.(string)}
+	}
+
+	var allInstances []instance.RouterInstance
+	for {
+		resp, err := instance.List(client, opts)
+		if err != nil {
+			return fmterr.Errorf("error querying ER instances: %w", err)
+		}
+		allInstances = append(allInstances, resp.Instances...)
+		if resp.PageInfo.NextMarker == "" || len(resp.Instances) == 0 {
+			break
+		}
+		opts.Marker = resp.PageInfo.NextMarker
+	}
+
+	name := d.Get("name").(string)
+	expectedTags := common.ExpandResourceTags(d.Get("tags").(map[string]interface{}))
+
+	var ids []string
+	var result []map[string]interface{}
+	for _, inst := range allInstances {
+		if name != "" && inst.Name != name {
+			continue
+		}
+		if !common.ContainsAllTags(inst.Tags, expectedTags) {
+			continue
+		}
+
+		ids = append(ids, inst.ID)
+		result = append(result, map[string]interface{}{
+			"id":                                 inst.ID,
+			"name":                               inst.Name,
+			"description":                        inst.Description,
+			"asn":                                int(inst.Asn),
+			"status":                             inst.State,
+			"availability_zones":                 inst.AvailabilityZoneIDs,
+			"enable_default_propagation":         inst.EnableDefaultPropagation,
+			"enable_default_association":         inst.EnableDefaultAssociation,
+			"auto_accept_shared_attachments":     inst.AutoAcceptSharedAttachments,
+			"default_propagation_route_table_id": inst.DefaultPropagationRouteTableID,
+			"default_association_route_table_id": inst.DefaultAssociationRouteTableID,
+			"tags":                               common.TagsToMap(inst.Tags),
+			"created_at":                         inst.CreatedAt,
+			"updated_at":                         inst.UpdatedAt,
+		})
+	}
+
+	log.Printf("[DEBUG] Retrieved %d ER instances using given filter", len(ids))
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("instances", result),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
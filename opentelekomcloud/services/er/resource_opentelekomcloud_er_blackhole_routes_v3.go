@@ -0,0 +1,299 @@
+package er
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/pointerto"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/er/v3/route"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceErBlackholeRoutesV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBlackholeRoutesV3Create,
+		UpdateContext: resourceBlackholeRoutesV3Update,
+		ReadContext:   resourceBlackholeRoutesV3Read,
+		DeleteContext: resourceBlackholeRoutesV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBlackholeRoutesV3ImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destinations": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+			"routes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// listStaticRoutes returns all static routes of the route table
+func listStaticRoutes(client *golangsdk.ServiceClient, routeTableId string) ([]route.Route, error) {
+	opts := route.ListStaticOpts{
+		RouteTableId: routeTableId,
+		Limit:        1000,
+	}
+
+	var result []route.Route
+	for {
+		resp, err := route.ListStatic(client, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, resp.Routes...)
+
+		if resp.PageInfo == nil || resp.PageInfo.NextMarker == "" || len(resp.Routes) == 0 {
+			return result, nil
+		}
+		opts.Marker = resp.PageInfo.NextMarker
+	}
+}
+
+// blackholeRoutesByDestination returns the black hole routes of the route table indexed by destination
+func blackholeRoutesByDestination(client *golangsdk.ServiceClient, routeTableId string) (map[string]route.Route, error) {
+	routes, err := listStaticRoutes(client, routeTableId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]route.Route)
+	for _, r := range routes {
+		if r.IsBlackhole {
+			result[r.Destination] = r
+		}
+	}
+	return result, nil
+}
+
+func createBlackholeRoutes(ctx context.Context, client *golangsdk.ServiceClient, routeTableId string,
+	destinations []string, timeout time.Duration) error {
+	for _, destination := range destinations {
+		resp, err := route.Create(client, route.CreateOpts{
+			RouteTableId: routeTableId,
+			Destination:  destination,
+			IsBlackhole:  pointerto.Bool(true),
+		})
+		if err != nil {
+			return fmt.Errorf("error creating black hole route (%s): %w", destination, err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"pending"},
+			Target:       []string{"available"},
+			Refresh:      staticRouteStatusRefreshFunc(client, routeTableId, resp.ID, []string{"available"}),
+			Timeout:      timeout,
+			PollInterval: 5 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for black hole route (%s) to become available: %w", destination, err)
+		}
+	}
+	return nil
+}
+
+func deleteBlackholeRoutes(ctx context.Context, client *golangsdk.ServiceClient, routeTableId string,
+	destinations []string, timeout time.Duration) error {
+	existing, err := blackholeRoutesByDestination(client, routeTableId)
+	if err != nil {
+		return fmt.Errorf("error listing static routes of the route table (%s): %w", routeTableId, err)
+	}
+
+	for _, destination := range destinations {
+		r, ok := existing[destination]
+		if !ok {
+			log.Printf("[DEBUG] Black hole route (%s) is already deleted", destination)
+			continue
+		}
+		if err := route.Delete(client, routeTableId, r.ID); err != nil {
+			return fmt.Errorf("error deleting black hole route (%s): %w", destination, err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"pending"},
+			Target:       []string{"COMPLETED"},
+			Refresh:      staticRouteStatusRefreshFunc(client, routeTableId, r.ID, nil),
+			Timeout:      timeout,
+			PollInterval: 5 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for black hole route (%s) to be deleted: %w", destination, err)
+		}
+	}
+	return nil
+}
+
+func resourceBlackholeRoutesV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, erClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.ErV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	routeTableId := d.Get("route_table_id").(string)
+	destinations := common.ExpandToStringListBySet(d.Get("destinations").(*schema.Set))
+	d.SetId(routeTableId)
+
+	if err := createBlackholeRoutes(ctx, client, routeTableId, destinations, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, erClientV3)
+	return resourceBlackholeRoutesV3Read(clientCtx, d, meta)
+}
+
+func resourceBlackholeRoutesV3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, erClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.ErV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	existing, err := blackholeRoutesByDestination(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "ER route table")
+	}
+
+	// only black hole routes managed by the resource are tracked,
+	// routes created outside (e.g. by `opentelekomcloud_er_static_route_v3`) are ignored
+	var destinations []string
+	var routes []map[string]interface{}
+	for _, destination := range common.ExpandToStringListBySet(d.Get("destinations").(*schema.Set)) {
+		r, ok := existing[destination]
+		if !ok {
+			continue
+		}
+		destinations = append(destinations, destination)
+		routes = append(routes, map[string]interface{}{
+			"id":          r.ID,
+			"destination": r.Destination,
+			"status":      r.State,
+		})
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("route_table_id", d.Id()),
+		d.Set("destinations", destinations),
+		d.Set("routes", routes),
+	)
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error saving black hole routes (%s) fields: %s", d.Id(), mErr)
+	}
+	return nil
+}
+
+func resourceBlackholeRoutesV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, erClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.ErV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	if d.HasChange("destinations") {
+		removed, added := common.GetSetChanges(d, "destinations")
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if err := deleteBlackholeRoutes(ctx, client, d.Id(), common.ExpandToStringListBySet(removed), timeout); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := createBlackholeRoutes(ctx, client, d.Id(), common.ExpandToStringListBySet(added), timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, erClientV3)
+	return resourceBlackholeRoutesV3Read(clientCtx, d, meta)
+}
+
+func resourceBlackholeRoutesV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, erClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.ErV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	destinations := common.ExpandToStringListBySet(d.Get("destinations").(*schema.Set))
+	if err := deleteBlackholeRoutes(ctx, client, d.Id(), destinations, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceBlackholeRoutesV3ImportState imports all black hole routes of the route table
+func resourceBlackholeRoutesV3ImportState(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData,
+	error) {
+	config := meta.(*cfg.Config)
+	client, err := config.ErV3Client(config.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf(errCreationV3Client, err)
+	}
+
+	existing, err := blackholeRoutesByDestination(client, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error listing static routes of the route table (%s): %w", d.Id(), err)
+	}
+	var destinations []string
+	for destination := range existing {
+		destinations = append(destinations, destination)
+	}
+	return []*schema.ResourceData{d}, d.Set("destinations", destinations)
+}
@@ -0,0 +1,17 @@
+package er
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceErDcAttachmentV3 manages the attachment created by the Direct Connect service
+// when the virtual gateway is attached to the ER instance
+func ResourceErDcAttachmentV3() *schema.Resource {
+	return typedAttachment{
+		kind:         "DC",
+		resourceType: "vgw",
+		updateType:   "dc",
+		idAttribute:  "virtual_gateway_id",
+		owner:        "DC virtual gateway",
+	}.resource()
+}
@@ -0,0 +1,17 @@
+package er
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceErVpnAttachmentV3 manages the attachment created by the VPN service
+// when the connection of the ER-attached VPN gateway is created
+func ResourceErVpnAttachmentV3() *schema.Resource {
+	return typedAttachment{
+		kind:         "VPN",
+		resourceType: "vpn",
+		updateType:   "vpn",
+		idAttribute:  "vpn_connection_id",
+		owner:        "VPN connection",
+	}.resource()
+}
@@ -0,0 +1,243 @@
+package er
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/pointerto"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// typedAttachment describes ER attachment which is created and deleted by another service together
+// with the attached resource, so ER resource only adopts it and manages its name and description
+type typedAttachment struct {
+	// kind is the attachment name used in messages, e.g. `VPN`
+	kind string
+	// resourceType is the `resource_type` of the attachment in ER API, e.g. `vgw`
+	resourceType string
+	// updateType is the type used in the type-specific update API, e.g. `dc` for `dc-attachments`
+	updateType string
+	// idAttribute is the schema attribute with the attached resource ID, e.g. `vpn_connection_id`
+	idAttribute string
+	// owner is the attached resource name used in messages, e.g. `VPN connection`
+	owner string
+}
+
+func (a typedAttachment) resource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: a.create,
+		UpdateContext: a.update,
+		ReadContext:   a.read,
+		DeleteContext: a.delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTypedAttachmentV3ImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			a.idAttribute: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 255),
+					validation.StringMatch(regexp.MustCompile(`^[^<>]*$`),
+						"The angle brackets (< and >) are not allowed."),
+				),
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (a typedAttachment) create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, erClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.ErV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	var (
+		instanceId = d.Get("instance_id").(string)
+		resourceId = d.Get(a.idAttribute).(string)
+	)
+
+	// the attachment is created by the owner service, so wait until it appears for the resource
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      attachmentByResourceRefreshFunc(client, instanceId, a.resourceType, resourceId),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	resp, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for %s attachment of the %s (%s): %s", a.kind, a.owner, resourceId, err)
+	}
+	d.SetId(resp.(attachment).ID)
+
+	if diags := a.updateAttachment(ctx, d, client); diags != nil {
+		return diags
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, erClientV3)
+	return a.read(clientCtx, d, meta)
+}
+
+func (a typedAttachment) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		config     = meta.(*cfg.Config)
+		instanceId = d.Get("instance_id").(string)
+		region     = config.GetRegion(d)
+	)
+
+	client, err := common.ClientFromCtx(ctx, erClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.ErV3Client(region)
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	resp, err := getAttachment(client, instanceId, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, fmt.Sprintf("ER %s attachment", a.kind))
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set(a.idAttribute, resp.ResourceID),
+		d.Set("name", resp.Name),
+		d.Set("description", resp.Description),
+		d.Set("route_table_id", resp.RouteTableID),
+		d.Set("associated", resp.Associated),
+		d.Set("status", resp.State),
+		d.Set("created_at", resp.CreatedAt),
+		d.Set("updated_at", resp.UpdatedAt),
+	)
+
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error saving %s attachment (%s) fields: %s", a.kind, d.Id(), mErr)
+	}
+	return nil
+}
+
+func (a typedAttachment) updateAttachment(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient) diag.Diagnostics {
+	if !d.HasChanges("name", "description") {
+		return nil
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	opts := attachmentUpdateOpts{
+		Name:        d.Get("name").(string),
+		Description: pointerto.String(d.Get("description").(string)),
+	}
+	if err := updateTypedAttachment(client, instanceId, a.updateType, d.Id(), opts); err != nil {
+		return diag.Errorf("error updating %s attachment (%s): %s", a.kind, d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      attachmentStatusRefreshFunc(client, instanceId, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (a typedAttachment) update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, erClientV3, func() (*golangsdk.ServiceClient, error) {
+		return config.ErV3Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	if diags := a.updateAttachment(ctx, d, client); diags != nil {
+		return diags
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, erClientV3)
+	return a.read(clientCtx, d, meta)
+}
+
+func (a typedAttachment) delete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// the attachment can't be deleted using ER API, it's removed together with the attached resource
+	log.Printf("[WARN] %s attachment (%s) is removed from the state only, delete %s (%s) to remove it",
+		a.kind, d.Id(), a.owner, d.Get(a.idAttribute).(string))
+	d.SetId("")
+	return nil
+}
+
+func resourceTypedAttachmentV3ImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData,
+	error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format for import ID, want '<instance_id>/<attachment_id>', but '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
@@ -1,7 +1,69 @@
 package evs
 
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
 const (
 	errCreationClient   = "error creating OpenTelekomCloud BlockStorageV3 client: %w"
 	errCreationClientV2 = "error creating OpenTelekomCloud BlockStorageV2 client: %w"
 	keyClientV2         = "evs-v2-client"
 )
+
+// configuredMetadata returns only the metadata keys set in the resource, as the
+// service can add own keys, which would force the replacement of ForceNew `metadata`
+func configuredMetadata(d *schema.ResourceData, metadata map[string]string) map[string]string {
+	result := make(map[string]string)
+	for key := range d.Get("metadata").(map[string]interface{}) {
+		if val, ok := metadata[key]; ok {
+			result[key] = val
+		}
+	}
+	return result
+}
+
+type retypeOpts struct {
+	// NewType is the name of the target volume type.
+	NewType string `json:"new_type" required:"true"`
+	// MigrationPolicy defines if the volume can be migrated to satisfy the new type.
+	MigrationPolicy string `json:"migration_policy,omitempty"`
+}
+
+// retypeVolume changes the type of the existing volume using `os-retype` volume action,
+// the same way as it is done for `os-extend` by volumeactions.ExtendSize.
+func retypeVolume(client *golangsdk.ServiceClient, id string, newType string) error {
+	opts := retypeOpts{
+		NewType:         newType,
+		MigrationPolicy: "on-demand",
+	}
+	b, err := golangsdk.BuildRequestBody(opts, "os-retype")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Post(client.ServiceURL("volumes", id, "action"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return err
+}
+
+type rollbackOpts struct {
+	// VolumeID is the ID of the volume the snapshot is rolled back to.
+	VolumeID string `json:"volume_id" required:"true"`
+	// Name is the new name of the volume, the name is kept if it's omitted.
+	Name string `json:"name,omitempty"`
+}
+
+// rollbackSnapshot rolls back the snapshot to the volume, the volume must be in `available` state.
+func rollbackSnapshot(client *golangsdk.ServiceClient, snapshotID string, opts rollbackOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "rollback")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Post(client.ServiceURL("os-vendor-snapshots", snapshotID, "rollback"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return err
+}
@@ -0,0 +1,132 @@
+package evs
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/evs/v2/snapshots"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceEvsSnapshotV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEvsSnapshotV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceEvsSnapshotV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.BlockStorageV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClientV2, err)
+	}
+
+	listOpts := snapshots.ListOpts{
+		ID:       d.Get("id").(string),
+		Name:     d.Get("name").(string),
+		VolumeID: d.Get("volume_id").(string),
+		Status:   d.Get("status").(string),
+	}
+	pages, err := snapshots.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("error listing EVS snapshots: %w", err)
+	}
+	found, err := snapshots.ExtractSnapshots(pages)
+	if err != nil {
+		return fmterr.Errorf("error extracting EVS snapshots: %w", err)
+	}
+
+	if len(found) < 1 {
+		return common.DataSourceTooFewDiag
+	}
+
+	snapshot := found[0]
+	if len(found) > 1 {
+		if !d.Get("most_recent").(bool) {
+			return common.DataSourceTooManyDiag
+		}
+		for _, s := range found[1:] {
+			if s.CreatedAt.After(snapshot.CreatedAt) {
+				snapshot = s
+			}
+		}
+	}
+
+	d.SetId(snapshot.ID)
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", snapshot.Name),
+		d.Set("volume_id", snapshot.VolumeID),
+		d.Set("status", snapshot.Status),
+		d.Set("description", snapshot.Description),
+		d.Set("size", snapshot.Size),
+		d.Set("metadata", snapshot.Metadata),
+		d.Set("created_at", snapshot.CreatedAt.Format(time.RFC3339)),
+		d.Set("updated_at", snapshot.UpdatedAt.Format(time.RFC3339)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting EVS snapshot fields: %w", err)
+	}
+
+	return nil
+}
@@ -37,6 +37,7 @@ func ResourceBlockStorageVolumeV2() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -95,7 +96,6 @@ func ResourceBlockStorageVolumeV2() *schema.Resource {
 			"volume_type": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
 			"device_type": {
@@ -357,6 +357,27 @@ func resourceBlockStorageVolumeV2Update(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	if d.HasChange("volume_type") {
+		if err := retypeVolume(client, d.Id(), d.Get("volume_type").(string)); err != nil {
+			return fmterr.Errorf("error changing type of volume (%s): %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"retyping"},
+			Target:       []string{"available", "in-use"},
+			Refresh:      VolumeV2StateRefreshFunc(client, d.Id()),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			Delay:        10 * time.Second,
+			MinTimeout:   3 * time.Second,
+			PollInterval: 2 * time.Second,
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return fmterr.Errorf("error waiting for volume (%s) to become ready after retype: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("size") {
 		if err := extendSize(d, client); err != nil {
 			return diag.FromErr(err)
@@ -366,7 +387,7 @@ func resourceBlockStorageVolumeV2Update(ctx context.Context, d *schema.ResourceD
 			Pending:      []string{"extending"},
 			Target:       []string{"available", "in-use"},
 			Refresh:      VolumeV2StateRefreshFunc(client, d.Id()),
-			Timeout:      d.Timeout(schema.TimeoutDelete),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
 			Delay:        10 * time.Second,
 			MinTimeout:   3 * time.Second,
 			PollInterval: 2 * time.Second,
@@ -0,0 +1,129 @@
+package evs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/evs/v2/snapshots"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceEvsSnapshotRollbackV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEvsSnapshotRollbackV2Create,
+		ReadContext:   resourceEvsSnapshotRollbackV2Read,
+		DeleteContext: resourceEvsSnapshotRollbackV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceEvsSnapshotRollbackV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.BlockStorageV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClientV2, err)
+	}
+
+	snapshotID := d.Get("snapshot_id").(string)
+	snapshot, err := snapshots.Get(client, snapshotID).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving EVS snapshot (%s): %w", snapshotID, err)
+	}
+
+	volumeID := d.Get("volume_id").(string)
+	if volumeID == "" {
+		volumeID = snapshot.VolumeID
+	}
+
+	opts := rollbackOpts{
+		VolumeID: volumeID,
+		Name:     d.Get("name").(string),
+	}
+	log.Printf("[DEBUG] Rolling back EVS snapshot (%s) to volume (%s)", snapshotID, volumeID)
+	if err := rollbackSnapshot(client, snapshotID, opts); err != nil {
+		return fmterr.Errorf("error rolling back EVS snapshot (%s) to volume (%s): %w", snapshotID, volumeID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"restoring-backup", "rollbacking"},
+		Target:       []string{"available"},
+		Refresh:      VolumeV2StateRefreshFunc(client, volumeID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		MinTimeout:   3 * time.Second,
+		PollInterval: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for volume (%s) to become available after rollback: %w", volumeID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", snapshotID, volumeID))
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV2)
+	return resourceEvsSnapshotRollbackV2Read(clientCtx, d, meta)
+}
+
+func resourceEvsSnapshotRollbackV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.BlockStorageV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClientV2, err)
+	}
+
+	// rollback is a one-time action, so only existence of the snapshot is checked
+	if _, err := snapshots.Get(client, d.Get("snapshot_id").(string)).Extract(); err != nil {
+		return common.CheckDeletedDiag(d, err, "EVS snapshot")
+	}
+
+	if err := d.Set("region", config.GetRegion(d)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceEvsSnapshotRollbackV2Delete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Rollback of EVS snapshot can't be undone, removing %s from state only", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,235 @@
+package evs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/evs/v2/snapshots"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceEvsSnapshotV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEvsSnapshotV2Create,
+		ReadContext:   resourceEvsSnapshotV2Read,
+		UpdateContext: resourceEvsSnapshotV2Update,
+		DeleteContext: resourceEvsSnapshotV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEvsSnapshotV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.BlockStorageV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClientV2, err)
+	}
+
+	metadata := make(map[string]string)
+	for key, val := range d.Get("metadata").(map[string]interface{}) {
+		metadata[key] = val.(string)
+	}
+
+	createOpts := snapshots.CreateOpts{
+		VolumeID:    d.Get("volume_id").(string),
+		Force:       d.Get("force").(bool),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Metadata:    metadata,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	snapshot, err := snapshots.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud EVS snapshot: %w", err)
+	}
+	d.SetId(snapshot.ID)
+
+	log.Printf("[DEBUG] Waiting for EVS snapshot (%s) to become available", snapshot.ID)
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"creating"},
+		Target:       []string{"available"},
+		Refresh:      evsSnapshotV2StateRefreshFunc(client, snapshot.ID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		MinTimeout:   3 * time.Second,
+		PollInterval: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for EVS snapshot (%s) to become available: %w", snapshot.ID, err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV2)
+	return resourceEvsSnapshotV2Read(clientCtx, d, meta)
+}
+
+func resourceEvsSnapshotV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.BlockStorageV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClientV2, err)
+	}
+
+	snapshot, err := snapshots.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "EVS snapshot")
+	}
+	log.Printf("[DEBUG] Retrieved EVS snapshot %s: %+v", d.Id(), snapshot)
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("volume_id", snapshot.VolumeID),
+		d.Set("name", snapshot.Name),
+		d.Set("description", snapshot.Description),
+		d.Set("metadata", configuredMetadata(d, snapshot.Metadata)),
+		d.Set("size", snapshot.Size),
+		d.Set("status", snapshot.Status),
+		d.Set("created_at", snapshot.CreatedAt.Format(time.RFC3339)),
+		d.Set("updated_at", snapshot.UpdatedAt.Format(time.RFC3339)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting EVS snapshot fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEvsSnapshotV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.BlockStorageV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClientV2, err)
+	}
+
+	updateOpts := snapshots.UpdateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+	if _, err := snapshots.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+		return fmterr.Errorf("error updating OpenTelekomCloud EVS snapshot: %w", err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV2)
+	return resourceEvsSnapshotV2Read(clientCtx, d, meta)
+}
+
+func resourceEvsSnapshotV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.BlockStorageV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClientV2, err)
+	}
+
+	if err := snapshots.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeletedDiag(d, err, "EVS snapshot")
+	}
+
+	log.Printf("[DEBUG] Waiting for EVS snapshot (%s) to delete", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"available", "deleting"},
+		Target:       []string{"deleted"},
+		Refresh:      evsSnapshotV2StateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		MinTimeout:   3 * time.Second,
+		PollInterval: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for EVS snapshot (%s) to delete: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func evsSnapshotV2StateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := snapshots.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return snapshot, "deleted", nil
+			}
+			return nil, "", err
+		}
+		if snapshot.Status == "error" || snapshot.Status == "error_deleting" {
+			return snapshot, snapshot.Status, fmt.Errorf("EVS snapshot is in the %s state", snapshot.Status)
+		}
+		return snapshot, snapshot.Status, nil
+	}
+}
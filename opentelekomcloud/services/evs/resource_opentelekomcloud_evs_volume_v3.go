@@ -36,6 +36,7 @@ func ResourceEvsStorageVolumeV3() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(3 * time.Minute),
 		},
 
@@ -83,7 +84,6 @@ func ResourceEvsStorageVolumeV3() *schema.Resource {
 			"volume_type": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"device_type": {
 				Type:         schema.TypeString,
@@ -295,6 +295,26 @@ func resourceEvsVolumeV3Update(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange("volume_type") {
+		if err := retypeVolume(client, d.Id(), d.Get("volume_type").(string)); err != nil {
+			return fmterr.Errorf("error changing type of volume (%s): %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"retyping"},
+			Target:     []string{"available", "in-use"},
+			Refresh:    volumeV3StateRefreshFunc(client, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return fmterr.Errorf("error waiting for volume (%s) to become ready after retype: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("size") {
 		if err := extendSize(d, client); err != nil {
 			return diag.FromErr(err)
@@ -304,7 +324,7 @@ func resourceEvsVolumeV3Update(ctx context.Context, d *schema.ResourceData, meta
 			Pending:    []string{"extending"},
 			Target:     []string{"available", "in-use"},
 			Refresh:    volumeV3StateRefreshFunc(client, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutDelete),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			Delay:      10 * time.Second,
 			MinTimeout: 3 * time.Second,
 		}
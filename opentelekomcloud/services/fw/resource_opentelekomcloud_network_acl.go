@@ -0,0 +1,700 @@
+package fw
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/fwaas_v2/firewall_groups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/fwaas_v2/policies"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/fwaas_v2/routerinsertion"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/fwaas_v2/rules"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/ports"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// networkACLPortOwner is the device owner of the router interface port of the VPC subnet
+const networkACLPortOwner = "network:router_interface_distributed"
+
+func ResourceNetworkACL() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkACLCreate,
+		ReadContext:   resourceNetworkACLRead,
+		UpdateContext: resourceNetworkACLUpdate,
+		DeleteContext: resourceNetworkACLDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subnets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"inbound_rules":  networkACLRulesSchema(),
+			"outbound_rules": networkACLRulesSchema(),
+			"inbound_policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"outbound_policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ports": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func networkACLRulesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"action": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"allow", "deny",
+					}, false),
+				},
+				"protocol": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"tcp", "udp", "icmp", "any",
+					}, false),
+				},
+				"ip_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      4,
+					ValidateFunc: validation.IntInSlice([]int{4, 6}),
+				},
+				"source_ip_address": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"destination_ip_address": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"source_port": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"destination_port": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	inboundPolicyID, err := createNetworkACLPolicy(networkingClient, name+"-inbound", d.Get("inbound_rules").([]interface{}))
+	if err != nil {
+		return fmterr.Errorf("error creating network ACL inbound policy: %w", err)
+	}
+	outboundPolicyID, err := createNetworkACLPolicy(networkingClient, name+"-outbound", d.Get("outbound_rules").([]interface{}))
+	if err != nil {
+		cleanupNetworkACLPolicies(networkingClient, inboundPolicyID)
+		return fmterr.Errorf("error creating network ACL outbound policy: %w", err)
+	}
+
+	portIDs, err := networkACLSubnetPorts(networkingClient, common.ExpandToStringListBySet(d.Get("subnets").(*schema.Set)))
+	if err != nil {
+		cleanupNetworkACLPolicies(networkingClient, inboundPolicyID, outboundPolicyID)
+		return diag.FromErr(err)
+	}
+
+	createOpts := routerinsertion.CreateOptsExt{
+		CreateOptsBuilder: firewall_groups.CreateOpts{
+			Name:            name,
+			Description:     d.Get("description").(string),
+			IngressPolicyID: inboundPolicyID,
+			EgressPolicyID:  outboundPolicyID,
+		},
+		PortIDs: portIDs,
+	}
+	log.Printf("[DEBUG] Create network ACL: %#v", createOpts)
+
+	group, err := firewall_groups.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		cleanupNetworkACLPolicies(networkingClient, inboundPolicyID, outboundPolicyID)
+		return fmterr.Errorf("error creating network ACL: %w", err)
+	}
+	d.SetId(group.ID)
+
+	if err := waitForNetworkACLActive(ctx, networkingClient, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for network ACL to be active: %w", err)
+	}
+	log.Printf("[DEBUG] Network ACL (%s) is active", d.Id())
+
+	return resourceNetworkACLRead(ctx, d, meta)
+}
+
+func resourceNetworkACLRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	var group FirewallGroup
+	if err := firewall_groups.Get(networkingClient, d.Id()).ExtractInto(&group); err != nil {
+		return common.CheckDeletedDiag(d, err, "network ACL")
+	}
+	log.Printf("[DEBUG] Read OpenTelekomCloud network ACL %s: %#v", d.Id(), group)
+
+	inboundRules, err := readNetworkACLRules(networkingClient, group.IngressPolicyID)
+	if err != nil {
+		return fmterr.Errorf("error reading network ACL inbound rules: %w", err)
+	}
+	outboundRules, err := readNetworkACLRules(networkingClient, group.EgressPolicyID)
+	if err != nil {
+		return fmterr.Errorf("error reading network ACL outbound rules: %w", err)
+	}
+
+	subnets := make([]string, 0, len(group.PortIDs))
+	for _, portID := range group.PortIDs {
+		port, err := ports.Get(networkingClient, portID).Extract()
+		if err != nil {
+			return fmterr.Errorf("error reading network ACL port (%s): %w", portID, err)
+		}
+		subnets = append(subnets, port.NetworkID)
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", group.Name),
+		d.Set("description", group.Description),
+		d.Set("inbound_policy_id", group.IngressPolicyID),
+		d.Set("outbound_policy_id", group.EgressPolicyID),
+		d.Set("inbound_rules", inboundRules),
+		d.Set("outbound_rules", outboundRules),
+		d.Set("subnets", subnets),
+		d.Set("ports", group.PortIDs),
+		d.Set("status", group.Status),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetworkACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	inboundPolicyID := d.Get("inbound_policy_id").(string)
+	outboundPolicyID := d.Get("outbound_policy_id").(string)
+
+	if d.HasChange("inbound_rules") {
+		oldRules, newRules := d.GetChange("inbound_rules")
+		if err := updateNetworkACLRules(networkingClient, inboundPolicyID, oldRules.([]interface{}), newRules.([]interface{})); err != nil {
+			return fmterr.Errorf("error updating network ACL inbound rules: %w", err)
+		}
+	}
+	if d.HasChange("outbound_rules") {
+		oldRules, newRules := d.GetChange("outbound_rules")
+		if err := updateNetworkACLRules(networkingClient, outboundPolicyID, oldRules.([]interface{}), newRules.([]interface{})); err != nil {
+			return fmterr.Errorf("error updating network ACL outbound rules: %w", err)
+		}
+	}
+
+	if d.HasChanges("name", "description", "subnets") {
+		// PolicyID is required
+		opts := firewall_groups.UpdateOpts{
+			IngressPolicyID: inboundPolicyID,
+			EgressPolicyID:  outboundPolicyID,
+			Name:            d.Get("name").(string),
+			Description:     d.Get("description").(string),
+		}
+
+		var updateOpts firewall_groups.UpdateOptsBuilder = opts
+		if d.HasChange("subnets") {
+			portIDs, err := networkACLSubnetPorts(networkingClient, common.ExpandToStringListBySet(d.Get("subnets").(*schema.Set)))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			updateOpts = routerinsertion.UpdateOptsExt{
+				UpdateOptsBuilder: opts,
+				PortIDs:           portIDs,
+			}
+		}
+
+		log.Printf("[DEBUG] Updating network ACL with id %s: %#v", d.Id(), updateOpts)
+		if err := firewall_groups.Update(networkingClient, d.Id(), updateOpts).Err; err != nil {
+			return fmterr.Errorf("error updating network ACL: %w", err)
+		}
+	}
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		if err := renameNetworkACLPolicy(networkingClient, inboundPolicyID, name+"-inbound"); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := renameNetworkACLPolicy(networkingClient, outboundPolicyID, name+"-outbound"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := waitForNetworkACLActive(ctx, networkingClient, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmterr.Errorf("error waiting for network ACL to become active: %w", err)
+	}
+
+	return resourceNetworkACLRead(ctx, d, meta)
+}
+
+func resourceNetworkACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	// Ensure the network ACL was fully created/updated before being deleted.
+	if err := waitForNetworkACLActive(ctx, networkingClient, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmterr.Errorf("error waiting for network ACL to be active: %w", err)
+	}
+
+	if err := firewall_groups.Delete(networkingClient, d.Id()).Err; err != nil {
+		return common.CheckDeletedDiag(d, err, "network ACL")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForFirewallGroupDeletion(networkingClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for network ACL to be deleted: %w", err)
+	}
+
+	for _, policyID := range []string{d.Get("inbound_policy_id").(string), d.Get("outbound_policy_id").(string)} {
+		if err := deleteNetworkACLPolicy(networkingClient, policyID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForNetworkACLActive(ctx context.Context, client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING_CREATE", "PENDING_UPDATE"},
+		Target:     []string{"ACTIVE", "INACTIVE"},
+		Refresh:    waitForFirewallGroupActive(client, id),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// networkACLSubnetPorts returns IDs of the router interface ports of the given VPC subnets
+func networkACLSubnetPorts(client *golangsdk.ServiceClient, subnets []string) ([]string, error) {
+	portIDs := make([]string, 0, len(subnets))
+	for _, subnetID := range subnets {
+		pages, err := ports.List(client, ports.ListOpts{
+			NetworkID:   subnetID,
+			DeviceOwner: networkACLPortOwner,
+		}).AllPages()
+		if err != nil {
+			return nil, fmt.Errorf("error listing ports of the subnet (%s): %w", subnetID, err)
+		}
+		subnetPorts, err := ports.ExtractPorts(pages)
+		if err != nil {
+			return nil, fmt.Errorf("error extracting ports of the subnet (%s): %w", subnetID, err)
+		}
+		if len(subnetPorts) == 0 {
+			return nil, fmt.Errorf("no router interface port found for the subnet (%s)", subnetID)
+		}
+		portIDs = append(portIDs, subnetPorts[0].ID)
+	}
+	return portIDs, nil
+}
+
+func createNetworkACLRule(client *golangsdk.ServiceClient, raw map[string]interface{}) (string, error) {
+	enabled := raw["enabled"].(bool)
+	opts := RuleCreateOpts{
+		CreateOpts: rules.CreateOpts{
+			Name:                 raw["name"].(string),
+			Description:          raw["description"].(string),
+			Protocol:             resourceFWRuleV2DetermineProtocol(raw["protocol"].(string)),
+			Action:               raw["action"].(string),
+			IPVersion:            resourceFWRuleV2DetermineIPVersion(raw["ip_version"].(int)),
+			SourceIPAddress:      raw["source_ip_address"].(string),
+			DestinationIPAddress: raw["destination_ip_address"].(string),
+			SourcePort:           raw["source_port"].(string),
+			DestinationPort:      raw["destination_port"].(string),
+			Enabled:              &enabled,
+		},
+	}
+	log.Printf("[DEBUG] Create network ACL rule: %#v", opts)
+
+	rule, err := rules.Create(client, opts).Extract()
+	if err != nil {
+		return "", fmt.Errorf("error creating network ACL rule: %w", err)
+	}
+	return rule.ID, nil
+}
+
+func createNetworkACLPolicy(client *golangsdk.ServiceClient, name string, rawRules []interface{}) (string, error) {
+	ruleIDs := make([]string, 0, len(rawRules))
+	for _, raw := range rawRules {
+		ruleID, err := createNetworkACLRule(client, raw.(map[string]interface{}))
+		if err != nil {
+			cleanupNetworkACLRules(client, ruleIDs)
+			return "", err
+		}
+		ruleIDs = append(ruleIDs, ruleID)
+	}
+
+	opts := PolicyCreateOpts{
+		CreateOpts: policies.CreateOpts{
+			Name:  name,
+			Rules: ruleIDs,
+		},
+	}
+	policy, err := policies.Create(client, opts).Extract()
+	if err != nil {
+		cleanupNetworkACLRules(client, ruleIDs)
+		return "", err
+	}
+	return policy.ID, nil
+}
+
+// deleteNetworkACLPolicy deletes the policy together with its rules,
+// the rules can't be removed while they are used in the policy
+func deleteNetworkACLPolicy(client *golangsdk.ServiceClient, policyID string) error {
+	if policyID == "" {
+		return nil
+	}
+	policy, err := policies.Get(client, policyID).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return nil
+		}
+		return fmt.Errorf("error reading network ACL policy (%s): %w", policyID, err)
+	}
+	if err := policies.Delete(client, policyID).Err; err != nil {
+		return fmt.Errorf("error deleting network ACL policy (%s): %w", policyID, err)
+	}
+	for _, ruleID := range policy.Rules {
+		if err := rules.Delete(client, ruleID).Err; err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return fmt.Errorf("error deleting network ACL rule (%s): %w", ruleID, err)
+		}
+	}
+	return nil
+}
+
+// cleanupNetworkACLPolicies removes the policies created before the failed step of
+// the network ACL creation, the errors are only logged to keep the original one
+func cleanupNetworkACLPolicies(client *golangsdk.ServiceClient, policyIDs ...string) {
+	for _, policyID := range policyIDs {
+		if err := deleteNetworkACLPolicy(client, policyID); err != nil {
+			log.Printf("[WARN] Failed to clean up network ACL policy (%s): %s", policyID, err)
+		}
+	}
+}
+
+// cleanupNetworkACLRules removes the rules created before the failed policy creation
+func cleanupNetworkACLRules(client *golangsdk.ServiceClient, ruleIDs []string) {
+	for _, ruleID := range ruleIDs {
+		if err := rules.Delete(client, ruleID).Err; err != nil {
+			log.Printf("[WARN] Failed to clean up network ACL rule (%s): %s", ruleID, err)
+		}
+	}
+}
+
+func renameNetworkACLPolicy(client *golangsdk.ServiceClient, policyID, name string) error {
+	policy, err := policies.Get(client, policyID).Extract()
+	if err != nil {
+		return fmt.Errorf("error reading network ACL policy (%s): %w", policyID, err)
+	}
+	// rules have to be passed, otherwise they are removed from the policy
+	opts := policies.UpdateOpts{
+		Name:  name,
+		Rules: policy.Rules,
+	}
+	if err := policies.Update(client, policyID, opts).Err; err != nil {
+		return fmt.Errorf("error updating network ACL policy (%s): %w", policyID, err)
+	}
+	return nil
+}
+
+func readNetworkACLRules(client *golangsdk.ServiceClient, policyID string) ([]map[string]interface{}, error) {
+	if policyID == "" {
+		return nil, nil
+	}
+	policy, err := policies.Get(client, policyID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, len(policy.Rules))
+	for i, ruleID := range policy.Rules {
+		rule, err := rules.Get(client, ruleID).Extract()
+		if err != nil {
+			return nil, err
+		}
+		protocol := rule.Protocol
+		if protocol == "" {
+			protocol = "any"
+		}
+		result[i] = map[string]interface{}{
+			"id":                     rule.ID,
+			"name":                   rule.Name,
+			"description":            rule.Description,
+			"action":                 rule.Action,
+			"protocol":               protocol,
+			"ip_version":             rule.IPVersion,
+			"source_ip_address":      rule.SourceIPAddress,
+			"destination_ip_address": rule.DestinationIPAddress,
+			"source_port":            rule.SourcePort,
+			"destination_port":       rule.DestinationPort,
+			"enabled":                rule.Enabled,
+		}
+	}
+	return result, nil
+}
+
+// networkACLRuleKey identifies the rule by its content, the rules with
+// the same content are considered to be the same rule
+func networkACLRuleKey(raw map[string]interface{}) string {
+	return fmt.Sprintf("%s|%s|%s|%s|%d|%s|%s|%s|%s|%t",
+		raw["name"], raw["description"], raw["action"], raw["protocol"], raw["ip_version"],
+		raw["source_ip_address"], raw["destination_ip_address"], raw["source_port"], raw["destination_port"],
+		raw["enabled"])
+}
+
+// updateNetworkACLRules applies the rule changes to the policy using the minimal
+// sequence of rule removals and insertions:
+//   - rules missing in the configuration are removed from the policy and deleted;
+//   - rules keeping their relative order (the longest common subsequence of the
+//     current and desired order) are not touched;
+//   - all other rules are (re-)inserted one by one right after their predecessor.
+func updateNetworkACLRules(client *golangsdk.ServiceClient, policyID string, oldRules, newRules []interface{}) error {
+	policy, err := policies.Get(client, policyID).Extract()
+	if err != nil {
+		return fmt.Errorf("error reading network ACL policy (%s): %w", policyID, err)
+	}
+	current := policy.Rules
+
+	inPolicy := make(map[string]bool, len(current))
+	for _, id := range current {
+		inPolicy[id] = true
+	}
+
+	available := make(map[string][]string)
+	for _, raw := range oldRules {
+		rule := raw.(map[string]interface{})
+		id := rule["id"].(string)
+		if !inPolicy[id] {
+			continue
+		}
+		ruleKey := networkACLRuleKey(rule)
+		available[ruleKey] = append(available[ruleKey], id)
+	}
+
+	desired := make([]string, len(newRules))
+	wanted := make(map[string]bool, len(newRules))
+	for i, raw := range newRules {
+		ruleKey := networkACLRuleKey(raw.(map[string]interface{}))
+		if ids := available[ruleKey]; len(ids) > 0 {
+			desired[i] = ids[0]
+			available[ruleKey] = ids[1:]
+			wanted[ids[0]] = true
+		}
+	}
+
+	var remaining []string
+	for _, id := range current {
+		if wanted[id] {
+			remaining = append(remaining, id)
+			continue
+		}
+		log.Printf("[DEBUG] Removing rule %s from network ACL policy %s", id, policyID)
+		if err := policies.RemoveRule(client, policyID, id).Err; err != nil {
+			return fmt.Errorf("error removing rule (%s) from policy (%s): %w", id, policyID, err)
+		}
+		if err := rules.Delete(client, id).Err; err != nil {
+			return fmt.Errorf("error deleting rule (%s): %w", id, err)
+		}
+	}
+
+	stable := networkACLStableRules(remaining, desired)
+	// order tracks the rule order of the policy while the rules are moved
+	order := remaining
+	previous := ""
+	for i, id := range desired {
+		if stable[id] {
+			previous = id
+			continue
+		}
+
+		if id == "" {
+			id, err = createNetworkACLRule(client, newRules[i].(map[string]interface{}))
+			if err != nil {
+				return err
+			}
+		} else {
+			log.Printf("[DEBUG] Moving rule %s in network ACL policy %s", id, policyID)
+			if err := policies.RemoveRule(client, policyID, id).Err; err != nil {
+				return fmt.Errorf("error removing rule (%s) from policy (%s): %w", id, policyID, err)
+			}
+			order = removeRuleID(order, id)
+		}
+
+		opts := policies.InsertRuleOpts{ID: id}
+		position := 0
+		switch {
+		case previous != "":
+			opts.AfterRuleID = previous
+			position = indexOfRuleID(order, previous) + 1
+		case len(order) > 0:
+			// the rule without predecessor goes to the top of the policy
+			opts.BeforeRuleID = order[0]
+		}
+		if err := policies.AddRule(client, policyID, opts).Err; err != nil {
+			return fmt.Errorf("error inserting rule (%s) into policy (%s): %w", id, policyID, err)
+		}
+		order = append(order[:position], append([]string{id}, order[position:]...)...)
+		previous = id
+	}
+
+	return nil
+}
+
+// networkACLStableRules returns the longest common subsequence of the current and desired
+// rule orders, these rules don't need to be moved to achieve the desired order
+func networkACLStableRules(current, desired []string) map[string]bool {
+	lcs := make([][]int, len(current)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(desired)+1)
+	}
+	for i := len(current) - 1; i >= 0; i-- {
+		for j := len(desired) - 1; j >= 0; j-- {
+			switch {
+			case current[i] == desired[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	stable := make(map[string]bool)
+	for i, j := 0, 0; i < len(current) && j < len(desired); {
+		switch {
+		case current[i] == desired[j]:
+			stable[current[i]] = true
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return stable
+}
+
+func indexOfRuleID(ids []string, id string) int {
+	for i, v := range ids {
+		if v == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func removeRuleID(ids []string, id string) []string {
+	if i := indexOfRuleID(ids, id); i >= 0 {
+		return append(ids[:i], ids[i+1:]...)
+	}
+	return ids
+}
@@ -0,0 +1,279 @@
+package fw
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func testNetworkACLRule(id, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                     id,
+		"name":                   name,
+		"description":            "",
+		"action":                 "allow",
+		"protocol":               "tcp",
+		"ip_version":             4,
+		"source_ip_address":      "",
+		"destination_ip_address": "",
+		"source_port":            "",
+		"destination_port":       "",
+		"enabled":                true,
+	}
+}
+
+// handleNetworkACLPolicy emulates the firewall policy API, keeping the rule
+// order of the policy and recording the calls made
+func handleNetworkACLPolicy(t *testing.T, policyID string, policyRules []string) (*[]string, *[]string) {
+	var ops []string
+
+	th.Mux.HandleFunc("/fwaas/firewall_policies/"+policyID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"firewall_policy": map[string]interface{}{"id": policyID, "firewall_rules": policyRules},
+		})
+	})
+	th.Mux.HandleFunc("/fwaas/firewall_policies/"+policyID+"/remove_rule", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		var body struct {
+			ID string `json:"firewall_rule_id"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+		ops = append(ops, "remove "+body.ID)
+		policyRules = removeRuleID(policyRules, body.ID)
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+	th.Mux.HandleFunc("/fwaas/firewall_policies/"+policyID+"/insert_rule", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		var body struct {
+			ID     string `json:"firewall_rule_id"`
+			After  string `json:"insert_after"`
+			Before string `json:"insert_before"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+		position := 0
+		switch {
+		case body.After != "":
+			ops = append(ops, fmt.Sprintf("insert %s after %s", body.ID, body.After))
+			position = indexOfRuleID(policyRules, body.After) + 1
+		case body.Before != "":
+			ops = append(ops, fmt.Sprintf("insert %s before %s", body.ID, body.Before))
+			position = indexOfRuleID(policyRules, body.Before)
+		default:
+			ops = append(ops, "insert "+body.ID)
+		}
+		policyRules = append(policyRules[:position], append([]string{body.ID}, policyRules[position:]...)...)
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+	th.Mux.HandleFunc("/fwaas/firewall_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		var body struct {
+			Rule struct {
+				Name string `json:"name"`
+			} `json:"firewall_rule"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+		id := "new-" + body.Rule.Name
+		ops = append(ops, "create "+id)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{"firewall_rule": {"id": %q}}`, id)
+	})
+	th.Mux.HandleFunc("/fwaas/firewall_rules/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		ops = append(ops, "delete "+strings.TrimPrefix(r.URL.Path, "/fwaas/firewall_rules/"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	return &ops, &policyRules
+}
+
+func TestUpdateNetworkACLRules(t *testing.T) {
+	oldRules := []interface{}{
+		testNetworkACLRule("a", "a"),
+		testNetworkACLRule("b", "b"),
+		testNetworkACLRule("c", "c"),
+	}
+	duplicateRules := []interface{}{
+		testNetworkACLRule("a", "a"),
+		testNetworkACLRule("b", "dup"),
+		testNetworkACLRule("c", "dup"),
+	}
+
+	cases := []struct {
+		name          string
+		oldRules      []interface{}
+		newRules      []string
+		expectedOps   []string
+		expectedRules []string
+	}{
+		{
+			name:          "no changes",
+			oldRules:      oldRules,
+			newRules:      []string{"a", "b", "c"},
+			expectedRules: []string{"a", "b", "c"},
+		},
+		{
+			name:     "insert in the middle",
+			oldRules: oldRules,
+			newRules: []string{"a", "x", "b", "c"},
+			expectedOps: []string{
+				"create new-x",
+				"insert new-x after a",
+			},
+			expectedRules: []string{"a", "new-x", "b", "c"},
+		},
+		{
+			name:     "insert at the top",
+			oldRules: oldRules,
+			newRules: []string{"x", "a", "b", "c"},
+			expectedOps: []string{
+				"create new-x",
+				"insert new-x before a",
+			},
+			expectedRules: []string{"new-x", "a", "b", "c"},
+		},
+		{
+			name:     "move to the top",
+			oldRules: oldRules,
+			newRules: []string{"c", "a", "b"},
+			expectedOps: []string{
+				"remove c",
+				"insert c before a",
+			},
+			expectedRules: []string{"c", "a", "b"},
+		},
+		{
+			name:     "move down",
+			oldRules: oldRules,
+			newRules: []string{"b", "c", "a"},
+			expectedOps: []string{
+				"remove a",
+				"insert a after c",
+			},
+			expectedRules: []string{"b", "c", "a"},
+		},
+		{
+			name:     "delete",
+			oldRules: oldRules,
+			newRules: []string{"a", "c"},
+			expectedOps: []string{
+				"remove b",
+				"delete b",
+			},
+			expectedRules: []string{"a", "c"},
+		},
+		{
+			name:     "delete and insert",
+			oldRules: oldRules,
+			newRules: []string{"x", "c"},
+			expectedOps: []string{
+				"remove a",
+				"delete a",
+				"remove b",
+				"delete b",
+				"create new-x",
+				"insert new-x before c",
+			},
+			expectedRules: []string{"new-x", "c"},
+		},
+		{
+			name:     "duplicate added",
+			oldRules: duplicateRules,
+			newRules: []string{"a", "dup", "dup", "dup"},
+			expectedOps: []string{
+				"create new-dup",
+				"insert new-dup after c",
+			},
+			expectedRules: []string{"a", "b", "c", "new-dup"},
+		},
+		{
+			name:     "duplicate removed",
+			oldRules: duplicateRules,
+			newRules: []string{"a", "dup"},
+			expectedOps: []string{
+				"remove c",
+				"delete c",
+			},
+			expectedRules: []string{"a", "b"},
+		},
+		{
+			name:     "duplicate moved",
+			oldRules: duplicateRules,
+			newRules: []string{"dup", "a", "dup"},
+			expectedOps: []string{
+				"remove a",
+				"insert a after b",
+			},
+			expectedRules: []string{"b", "a", "c"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			th.SetupHTTP()
+			defer th.TeardownHTTP()
+
+			var current []string
+			for _, raw := range c.oldRules {
+				current = append(current, raw.(map[string]interface{})["id"].(string))
+			}
+			ops, policyRules := handleNetworkACLPolicy(t, "p1", current)
+
+			newRules := make([]interface{}, len(c.newRules))
+			for i, name := range c.newRules {
+				newRules[i] = testNetworkACLRule("", name)
+			}
+
+			th.AssertNoErr(t, updateNetworkACLRules(fake.ServiceClient(), "p1", c.oldRules, newRules))
+			th.AssertDeepEquals(t, c.expectedOps, *ops)
+			th.AssertDeepEquals(t, c.expectedRules, *policyRules)
+		})
+	}
+}
+
+func TestNetworkACLStableRules(t *testing.T) {
+	th.AssertDeepEquals(t, map[string]bool{"a": true, "b": true},
+		networkACLStableRules([]string{"a", "b", "c"}, []string{"c", "a", "b"}))
+	th.AssertDeepEquals(t, map[string]bool{"b": true, "c": true},
+		networkACLStableRules([]string{"a", "b", "c"}, []string{"b", "c", "a"}))
+	th.AssertDeepEquals(t, map[string]bool{"c": true},
+		networkACLStableRules([]string{"c"}, []string{"", "c"}))
+	th.AssertDeepEquals(t, map[string]bool{},
+		networkACLStableRules(nil, []string{"", ""}))
+}
+
+func TestCreateNetworkACLPolicyCleanup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	ops, _ := handleNetworkACLPolicy(t, "p1", nil)
+	th.Mux.HandleFunc("/fwaas/firewall_policies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		*ops = append(*ops, "create policy")
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := createNetworkACLPolicy(fake.ServiceClient(), "acl-inbound", []interface{}{
+		testNetworkACLRule("", "a"),
+		testNetworkACLRule("", "b"),
+	})
+	if err == nil {
+		t.Fatal("expected policy creation error")
+	}
+	th.AssertDeepEquals(t, []string{
+		"create new-a",
+		"create new-b",
+		"create policy",
+		"delete new-a",
+		"delete new-b",
+	}, *ops)
+}
@@ -1,5 +1,64 @@
 package nat
 
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/dnatrules"
+)
+
 const (
 	ErrCreationClient = "error creating OpenTelekomCloud NATv2 client: %w"
 )
+
+// dnatRuleCreateOpts extends SDK options with port ranges and global EIP,
+// `internal_service_port`, `external_service_port` and `floating_ip_id` are optional there
+type dnatRuleCreateOpts struct {
+	NatGatewayID             string `json:"nat_gateway_id" required:"true"`
+	PortID                   string `json:"port_id,omitempty"`
+	PrivateIp                string `json:"private_ip,omitempty"`
+	InternalServicePort      *int   `json:"internal_service_port,omitempty"`
+	ExternalServicePort      *int   `json:"external_service_port,omitempty"`
+	InternalServicePortRange string `json:"internal_service_port_range,omitempty"`
+	ExternalServicePortRange string `json:"external_service_port_range,omitempty"`
+	FloatingIpID             string `json:"floating_ip_id,omitempty"`
+	GlobalEipID              string `json:"global_eip_id,omitempty"`
+	Protocol                 string `json:"protocol" required:"true"`
+	Description              string `json:"description,omitempty"`
+}
+
+// dnatRule is the DNAT rule with fields missing in the SDK structure
+type dnatRule struct {
+	dnatrules.DnatRule
+	InternalServicePortRange string `json:"internal_service_port_range"`
+	ExternalServicePortRange string `json:"external_service_port_range"`
+	GlobalEipID              string `json:"global_eip_id"`
+	GlobalEipAddress         string `json:"global_eip_address"`
+}
+
+func dnatRuleCreate(client *golangsdk.ServiceClient, opts dnatRuleCreateOpts) (*dnatRule, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "dnat_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("dnat_rules"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var rule dnatRule
+	return &rule, r.ExtractIntoStructPtr(&rule, "dnat_rule")
+}
+
+func dnatRuleGet(client *golangsdk.ServiceClient, id string) (*dnatRule, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("dnat_rules", id), &r.Body, nil)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var rule dnatRule
+	return &rule, r.ExtractIntoStructPtr(&rule, "dnat_rule")
+}
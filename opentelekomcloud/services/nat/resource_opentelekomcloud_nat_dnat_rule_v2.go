@@ -2,7 +2,10 @@ package nat
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -35,15 +38,34 @@ func ResourceNatDnatRuleV2() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"floating_ip_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				ExactlyOneOf: []string{"floating_ip_id", "global_eip_id"},
+			},
+			"global_eip_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.IsUUID,
 			},
 			"internal_service_port": {
 				Type:         schema.TypeInt,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(0, 65535),
+				ExactlyOneOf: []string{"internal_service_port", "internal_service_port_range"},
+			},
+			"internal_service_port_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePortRange,
+				RequiredWith: []string{"external_service_port_range"},
 			},
 			"nat_gateway_id": {
 				Type:         schema.TypeString,
@@ -75,9 +97,23 @@ func ResourceNatDnatRuleV2() *schema.Resource {
 			},
 			"external_service_port": {
 				Type:         schema.TypeInt,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(0, 65535),
+				ExactlyOneOf: []string{"external_service_port", "external_service_port_range"},
+			},
+			"external_service_port_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePortRange,
+				RequiredWith: []string{"internal_service_port_range"},
+			},
+			"global_eip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"created_at": {
 				Type:     schema.TypeString,
@@ -112,16 +148,24 @@ func resourceNatDnatRuleCreate(ctx context.Context, d *schema.ResourceData, meta
 		return fmterr.Errorf("both `port_id` and `private_ip` are empty, must specify one of them.")
 	}
 
-	externalServicePort := d.Get("external_service_port").(int)
-	internalServicePort := d.Get("internal_service_port").(int)
-	createOpts := dnatrules.CreateOpts{
-		NatGatewayID:        d.Get("nat_gateway_id").(string),
-		PortID:              portID.(string),
-		PrivateIp:           privateIp.(string),
-		InternalServicePort: &internalServicePort,
-		FloatingIpID:        d.Get("floating_ip_id").(string),
-		ExternalServicePort: &externalServicePort,
-		Protocol:            d.Get("protocol").(string),
+	createOpts := dnatRuleCreateOpts{
+		NatGatewayID:             d.Get("nat_gateway_id").(string),
+		PortID:                   portID.(string),
+		PrivateIp:                privateIp.(string),
+		InternalServicePortRange: d.Get("internal_service_port_range").(string),
+		ExternalServicePortRange: d.Get("external_service_port_range").(string),
+		FloatingIpID:             d.Get("floating_ip_id").(string),
+		GlobalEipID:              d.Get("global_eip_id").(string),
+		Protocol:                 d.Get("protocol").(string),
+	}
+	// port `0` is valid value, so `GetOkExists` is used
+	if v, ok := d.GetOkExists("internal_service_port"); ok { // nolint:staticcheck
+		internalServicePort := v.(int)
+		createOpts.InternalServicePort = &internalServicePort
+	}
+	if v, ok := d.GetOkExists("external_service_port"); ok { // nolint:staticcheck
+		externalServicePort := v.(int)
+		createOpts.ExternalServicePort = &externalServicePort
 	}
 
 	rule, err := createRuleWithRetry(ctx, client, createOpts, time.Minute)
@@ -151,11 +195,11 @@ var createRulePollInterval = 5 * time.Second
 
 // createRuleWithRetry retries creation of DNAT rule in case err 400 is received (handling DnatRuleInValidPortID erorr)
 // time between requests is set by createRulePollInterval
-func createRuleWithRetry(ctx context.Context, client *golangsdk.ServiceClient, opts dnatrules.CreateOpts, timeout time.Duration) (*dnatrules.DnatRule, error) {
-	var rule *dnatrules.DnatRule
+func createRuleWithRetry(ctx context.Context, client *golangsdk.ServiceClient, opts dnatRuleCreateOpts, timeout time.Duration) (*dnatRule, error) {
+	var rule *dnatRule
 	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
 		var err error
-		rule, err = dnatrules.Create(client, opts)
+		rule, err = dnatRuleCreate(client, opts)
 		if err != nil {
 			// we are retrying DnatRuleInValidPortID which is HTTP 400
 			if _, ok := err.(golangsdk.ErrDefault400); ok {
@@ -179,14 +223,18 @@ func resourceNatDnatRuleRead(_ context.Context, d *schema.ResourceData, meta int
 	if err != nil {
 		return fmterr.Errorf(ErrCreationClient, err)
 	}
-	dnatRule, err := dnatrules.Get(client, d.Id())
+	dnatRule, err := dnatRuleGet(client, d.Id())
 	if err != nil {
 		return common.CheckDeletedDiag(d, err, "dnat rule")
 	}
 
 	mErr := multierror.Append(
 		d.Set("floating_ip_id", dnatRule.FloatingIpId),
+		d.Set("global_eip_id", dnatRule.GlobalEipID),
+		d.Set("global_eip_address", dnatRule.GlobalEipAddress),
 		d.Set("internal_service_port", dnatRule.InternalServicePort),
+		d.Set("internal_service_port_range", dnatRule.InternalServicePortRange),
+		d.Set("external_service_port_range", dnatRule.ExternalServicePortRange),
 		d.Set("nat_gateway_id", dnatRule.NatGatewayId),
 		d.Set("port_id", dnatRule.PortId),
 		d.Set("private_ip", dnatRule.PrivateIp),
@@ -230,6 +278,20 @@ func resourceNatDnatRuleDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+func validatePortRange(v interface{}, k string) (ws []string, errors []error) {
+	parts := strings.Split(v.(string), "-")
+	if len(parts) != 2 {
+		errors = append(errors, fmt.Errorf("%q must be in format `<start>-<end>`, got %q", k, v))
+		return
+	}
+	start, startErr := strconv.Atoi(parts[0])
+	end, endErr := strconv.Atoi(parts[1])
+	if startErr != nil || endErr != nil || start < 1 || end > 65535 || start > end {
+		errors = append(errors, fmt.Errorf("%q must be a valid port range within 1-65535, got %q", k, v))
+	}
+	return
+}
+
 func waitForDnatRuleActive(client *golangsdk.ServiceClient, dnatRuleID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		n, err := dnatrules.Get(client, dnatRuleID)
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/natgateways"
@@ -31,6 +32,7 @@ func ResourceNatGatewayV2() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -53,6 +55,9 @@ func ResourceNatGatewayV2() *schema.Resource {
 			"spec": {
 				Type:     schema.TypeString,
 				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"0", "1", "2", "3", "4",
+				}, false),
 			},
 			"tenant_id": {
 				Type:     schema.TypeString,
@@ -193,6 +198,20 @@ func resourceNatGatewayV2Update(ctx context.Context, d *schema.ResourceData, met
 		return fmterr.Errorf("error updating NAT Gateway: %w", err)
 	}
 
+	if d.HasChange("spec") {
+		stateConf := &resource.StateChangeConf{
+			Target:     []string{"ACTIVE"},
+			Refresh:    waitForNatGatewayActive(client, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmterr.Errorf("error waiting for OpenTelekomCloud NAT Gateway spec update: %w", err)
+		}
+	}
+
 	// update tags
 	if config.GetRegion(d) != "eu-ch2" {
 		if d.HasChange("tags") {
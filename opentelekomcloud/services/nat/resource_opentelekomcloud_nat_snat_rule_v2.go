@@ -2,8 +2,10 @@ package nat
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -66,10 +68,15 @@ func ResourceNatSnatRuleV2() *schema.Resource {
 				ForceNew: true,
 			},
 			"floating_ip_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.IsUUID,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateFloatingIPIDs,
+				DiffSuppressFunc: suppressFloatingIPIDsDiff,
+			},
+			"floating_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 		},
 	}
@@ -91,7 +98,7 @@ func resourceNatSnatRuleV2Create(ctx context.Context, d *schema.ResourceData, me
 	createOpts := snatrules.CreateOpts{
 		NatGatewayID: d.Get("nat_gateway_id").(string),
 		NetworkID:    networkID.(string),
-		FloatingIPID: d.Get("floating_ip_id").(string),
+		FloatingIPID: strings.Join(common.ExpandToStringListBySet(splitFloatingIPIDs(d.Get("floating_ip_id").(string))), ","),
 		SourceType:   d.Get("source_type").(int),
 		Cidr:         cidr.(string),
 	}
@@ -138,6 +145,7 @@ func resourceNatSnatRuleV2Read(_ context.Context, d *schema.ResourceData, meta i
 		d.Set("nat_gateway_id", snatRule.NatGatewayID),
 		d.Set("network_id", snatRule.NetworkID),
 		d.Set("floating_ip_id", snatRule.FloatingIPID),
+		d.Set("floating_ip_address", snatRule.FloatingIPAddress),
 		d.Set("cidr", snatRule.Cidr),
 		d.Set("region", config.GetRegion(d)),
 	)
@@ -191,6 +199,36 @@ func resourceNatSnatRuleV2Delete(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
+// splitFloatingIPIDs returns set of the IDs from comma-separated list
+func splitFloatingIPIDs(v string) *schema.Set {
+	ids := schema.NewSet(schema.HashString, nil)
+	for _, id := range strings.Split(v, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids.Add(id)
+		}
+	}
+	return ids
+}
+
+func validateFloatingIPIDs(v interface{}, k string) (ws []string, errors []error) {
+	ids := splitFloatingIPIDs(v.(string))
+	if ids.Len() == 0 || ids.Len() > 20 {
+		errors = append(errors, fmt.Errorf("%q must contain from 1 to 20 floating IP IDs, got %d", k, ids.Len()))
+		return
+	}
+	for _, id := range ids.List() {
+		w, e := validation.IsUUID(id, k)
+		ws = append(ws, w...)
+		errors = append(errors, e...)
+	}
+	return
+}
+
+// suppressFloatingIPIDsDiff ignores order of the floating IP IDs
+func suppressFloatingIPIDsDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return splitFloatingIPIDs(old).Equal(splitFloatingIPIDs(new))
+}
+
 func waitForSnatRuleActive(client *golangsdk.ServiceClient, snatRuleID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		n, err := snatrules.Get(client, snatRuleID)
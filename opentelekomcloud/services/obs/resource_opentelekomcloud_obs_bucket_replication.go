@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/agency"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
@@ -122,6 +124,7 @@ func buildReplicationRules(d *schema.ResourceData) ([]obs.ReplicationRule, error
 	rawArray := d.Get("rule").([]interface{})
 
 	replicationRules := make([]obs.ReplicationRule, 0, len(rawArray))
+	prefixes := make([]string, 0, len(rawArray))
 	for _, raw := range rawArray {
 		if rawMap, rawOk := raw.(map[string]interface{}); rawOk {
 			prefix := rawMap["prefix"].(string)
@@ -129,6 +132,10 @@ func buildReplicationRules(d *schema.ResourceData) ([]obs.ReplicationRule, error
 				return nil, fmt.Errorf("to apply a rule to all objects, delete all rules that take effect" +
 					" by prefixes first")
 			}
+			if err := checkReplicationPrefixOverlap(prefixes, prefix); err != nil {
+				return nil, err
+			}
+			prefixes = append(prefixes, prefix)
 			replicationRules = append(replicationRules, buildReplicationRuleFromRawMap(rawMap, destBucket, prefix))
 		}
 	}
@@ -142,6 +149,44 @@ func buildReplicationRules(d *schema.ResourceData) ([]obs.ReplicationRule, error
 	return replicationRules, nil
 }
 
+// checkReplicationPrefixOverlap verifies that the prefix neither duplicates nor contains
+// any of the prefixes used by the previous rules, as OBS rejects overlapping rules.
+func checkReplicationPrefixOverlap(prefixes []string, prefix string) error {
+	for _, existing := range prefixes {
+		if strings.HasPrefix(prefix, existing) || strings.HasPrefix(existing, prefix) {
+			return fmt.Errorf("replication rule prefixes %q and %q overlap, "+
+				"each object can match only one rule", existing, prefix)
+		}
+	}
+	return nil
+}
+
+func checkReplicationAgencyExists(config *cfg.Config, name string) error {
+	client, err := config.IdentityV30Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %w", err)
+	}
+
+	opts := agency.ListOpts{
+		Name:     name,
+		DomainID: getDomainID(config),
+	}
+	pages, err := agency.List(client, opts).AllPages()
+	if err != nil {
+		return fmt.Errorf("error listing agencies: %w", err)
+	}
+	agencies, err := agency.ExtractAgencies(pages)
+	if err != nil {
+		return fmt.Errorf("error extracting agencies: %w", err)
+	}
+	for _, a := range agencies {
+		if a.Name == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("IAM agency %s doesn't exist, it must be created before configuring replication", name)
+}
+
 func resourceObsBucketReplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 	client, err := config.NewObjectStorageClient(config.GetRegion(d))
@@ -154,10 +199,18 @@ func resourceObsBucketReplicationCreate(ctx context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	agencyName := d.Get("agency").(string)
+	if d.IsNewResource() || d.HasChange("agency") {
+		if err := checkReplicationAgencyExists(config, agencyName); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	opts := &obs.SetBucketReplicationInput{
 		Bucket: bucket,
 		BucketReplicationConfiguration: obs.BucketReplicationConfiguration{
-			Agency:           d.Get("agency").(string),
+			Agency:           agencyName,
 			ReplicationRules: replicationRules,
 		},
 	}
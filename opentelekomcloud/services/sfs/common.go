@@ -1,6 +1,66 @@
 package sfs
 
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
 const (
 	errCreationClient = "error creating OpenTelekomCloud SFSTurboV1 client: %w"
 	keyClientV1       = "sfs-v1-client"
 )
+
+// obsTargetOpts describes an OBS bucket bound to SFS Turbo as a storage backend.
+type obsTargetOpts struct {
+	// FileSystemPath is the linkage directory name in the SFS Turbo file system.
+	FileSystemPath string `json:"file_system_path" required:"true"`
+	// OBS contains the bucket parameters.
+	OBS obsTargetBucket `json:"obs" required:"true"`
+}
+
+type obsTargetBucket struct {
+	Bucket   string `json:"bucket" required:"true"`
+	Endpoint string `json:"endpoint" required:"true"`
+}
+
+type obsTarget struct {
+	ID             string          `json:"target_id"`
+	FileSystemPath string          `json:"file_system_path"`
+	Lifecycle      string          `json:"lifecycle"`
+	OBS            obsTargetBucket `json:"obs"`
+}
+
+func obsTargetsURL(client *golangsdk.ServiceClient, shareID string, parts ...string) string {
+	return client.ServiceURL(append([]string{"sfs-turbo", "shares", shareID, "targets"}, parts...)...)
+}
+
+func createOBSTarget(client *golangsdk.ServiceClient, shareID string, opts obsTargetOpts) (*obsTarget, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var target obsTarget
+	_, err = client.Post(obsTargetsURL(client, shareID), b, &target, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &target, nil
+}
+
+func getOBSTarget(client *golangsdk.ServiceClient, shareID, targetID string) (*obsTarget, error) {
+	var target obsTarget
+	_, err := client.Get(obsTargetsURL(client, shareID, targetID), &target, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &target, nil
+}
+
+func deleteOBSTarget(client *golangsdk.ServiceClient, shareID, targetID string) error {
+	_, err := client.Delete(obsTargetsURL(client, shareID, targetID), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	return err
+}
@@ -0,0 +1,82 @@
+package sfs
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	testShareID  = "8fba8253-c914-439d-ae8b-d5c89d6a8d4b"
+	testTargetID = "1a2b3c4d-5e6f-7a8b-9c0d-1e2f3a4b5c6d"
+)
+
+var obsTargetResponse = fmt.Sprintf(`
+{
+  "target_id": "%s",
+  "file_system_path": "sfs-link-directory",
+  "lifecycle": "AVAILABLE",
+  "obs": {
+    "bucket": "tf-test-bucket",
+    "endpoint": "obs.eu-de.otc.t-systems.com"
+  }
+}`, testTargetID)
+
+var expectedOBSTarget = obsTarget{
+	ID:             testTargetID,
+	FileSystemPath: "sfs-link-directory",
+	Lifecycle:      "AVAILABLE",
+	OBS: obsTargetBucket{
+		Bucket:   "tf-test-bucket",
+		Endpoint: "obs.eu-de.otc.t-systems.com",
+	},
+}
+
+func TestCreateOBSTarget(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(fmt.Sprintf("/sfs-turbo/shares/%s/targets", testShareID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "file_system_path": "sfs-link-directory",
+  "obs": {
+    "bucket": "tf-test-bucket",
+    "endpoint": "obs.eu-de.otc.t-systems.com"
+  }
+}`)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, obsTargetResponse)
+	})
+
+	target, err := createOBSTarget(fake.ServiceClient(), testShareID, obsTargetOpts{
+		FileSystemPath: "sfs-link-directory",
+		OBS: obsTargetBucket{
+			Bucket:   "tf-test-bucket",
+			Endpoint: "obs.eu-de.otc.t-systems.com",
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, expectedOBSTarget, *target)
+}
+
+func TestGetOBSTarget(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(fmt.Sprintf("/sfs-turbo/shares/%s/targets/%s", testShareID, testTargetID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, obsTargetResponse)
+	})
+
+	target, err := getOBSTarget(fake.ServiceClient(), testShareID, testTargetID)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, expectedOBSTarget, *target)
+}
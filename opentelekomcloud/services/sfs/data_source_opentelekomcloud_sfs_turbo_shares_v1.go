@@ -0,0 +1,191 @@
+package sfs
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/sfs_turbo/v1/shares"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceSFSTurboSharesV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSFSTurboSharesV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"shares": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"share_proto": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"share_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"export_location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"available_capacity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expand_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSFSTurboSharesV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.SfsTurboV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	pages, err := shares.List(client, shares.ListOpts{}).AllPages()
+	if err != nil {
+		return fmterr.Errorf("error listing SFS turbo shares: %w", err)
+	}
+	turbos, err := shares.ExtractTurbos(pages)
+	if err != nil {
+		return fmterr.Errorf("error extracting SFS turbo shares: %w", err)
+	}
+
+	filters := map[string]string{
+		"name":              d.Get("name").(string),
+		"vpc_id":            d.Get("vpc_id").(string),
+		"subnet_id":         d.Get("subnet_id").(string),
+		"availability_zone": d.Get("availability_zone").(string),
+	}
+
+	var ids []string
+	var result []map[string]interface{}
+	for _, share := range turbos {
+		actual := map[string]string{
+			"name":              share.Name,
+			"vpc_id":            share.VpcID,
+			"subnet_id":         share.SubnetID,
+			"availability_zone": share.AvailabilityZone,
+		}
+		if !matchesFilters(filters, actual) {
+			continue
+		}
+
+		// share.Size is a string of float64
+		fSize, err := strconv.ParseFloat(share.Size, 64)
+		if err != nil {
+			return fmterr.Errorf("error parsing SFS Turbo sharing size: %w", err)
+		}
+
+		ids = append(ids, share.ID)
+		result = append(result, map[string]interface{}{
+			"id":                 share.ID,
+			"name":               share.Name,
+			"size":               fSize,
+			"status":             share.Status,
+			"share_proto":        share.ShareProto,
+			"share_type":         share.ShareType,
+			"availability_zone":  share.AvailabilityZone,
+			"vpc_id":             share.VpcID,
+			"subnet_id":          share.SubnetID,
+			"security_group_id":  share.SecurityGroupID,
+			"version":            share.Version,
+			"export_location":    share.ExportLocation,
+			"available_capacity": share.AvailCapacity,
+			"expand_type":        share.ExpandType,
+		})
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("shares", result),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting SFS Turbo shares fields: %w", err)
+	}
+
+	return nil
+}
+
+func matchesFilters(filters, actual map[string]string) bool {
+	for key, expected := range filters {
+		if expected != "" && actual[key] != expected {
+			return false
+		}
+	}
+	return true
+}
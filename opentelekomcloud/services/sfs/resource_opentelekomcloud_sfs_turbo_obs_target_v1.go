@@ -0,0 +1,201 @@
+package sfs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceSFSTurboOBSTargetV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSFSTurboOBSTargetV1Create,
+		ReadContext:   resourceSFSTurboOBSTargetV1Read,
+		DeleteContext: resourceSFSTurboOBSTargetV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: common.ImportByPath("share_id", "id"),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"share_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"file_system_path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"obs": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"endpoint": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSFSTurboOBSTargetV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.SfsTurboV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	shareID := d.Get("share_id").(string)
+	obsRaw := d.Get("obs").([]interface{})[0].(map[string]interface{})
+	opts := obsTargetOpts{
+		FileSystemPath: d.Get("file_system_path").(string),
+		OBS: obsTargetBucket{
+			Bucket:   obsRaw["bucket"].(string),
+			Endpoint: obsRaw["endpoint"].(string),
+		},
+	}
+
+	log.Printf("[DEBUG] Create SFS Turbo OBS target with option: %+v", opts)
+	target, err := createOBSTarget(client, shareID, opts)
+	if err != nil {
+		return fmterr.Errorf("error binding OBS bucket to OpenTelekomCloud SFS Turbo (%s): %s", shareID, err)
+	}
+	d.SetId(target.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"CREATING"},
+		Target:       []string{"AVAILABLE"},
+		Refresh:      waitForSFSTurboOBSTargetStatus(client, shareID, target.ID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		MinTimeout:   3 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for SFS Turbo OBS target (%s) to become available: %s", target.ID, err)
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV1)
+	return resourceSFSTurboOBSTargetV1Read(clientCtx, d, meta)
+}
+
+func resourceSFSTurboOBSTargetV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.SfsTurboV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	target, err := getOBSTarget(client, d.Get("share_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "SFS Turbo OBS target")
+	}
+
+	obs := []map[string]interface{}{
+		{
+			"bucket":   target.OBS.Bucket,
+			"endpoint": target.OBS.Endpoint,
+		},
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("file_system_path", target.FileSystemPath),
+		d.Set("obs", obs),
+		d.Set("status", target.Lifecycle),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting SFS Turbo OBS target fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSFSTurboOBSTargetV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.SfsTurboV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	shareID := d.Get("share_id").(string)
+	if err := deleteOBSTarget(client, shareID, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "SFS Turbo OBS target")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"AVAILABLE", "DELETING"},
+		Target:       []string{"deleted"},
+		Refresh:      waitForSFSTurboOBSTargetStatus(client, shareID, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		MinTimeout:   3 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for SFS Turbo OBS target (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForSFSTurboOBSTargetStatus(client *golangsdk.ServiceClient, shareID, targetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		target, err := getOBSTarget(client, shareID, targetID)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return target, "deleted", nil
+			}
+			return target, "error", err
+		}
+		if target.Lifecycle == "FAILED" || target.Lifecycle == "MISCONFIGURED" {
+			return target, target.Lifecycle, fmt.Errorf("SFS Turbo OBS target is in %s state", target.Lifecycle)
+		}
+		return target, target.Lifecycle, nil
+	}
+}
@@ -32,6 +32,7 @@ func ResourceSFSTurboShareV1() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -241,7 +242,7 @@ func resourceSFSTurboShareV1Update(ctx context.Context, d *schema.ResourceData,
 			Pending:      []string{"121"},
 			Target:       []string{"221", "232"},
 			Refresh:      waitForSFSTurboSubStatus(client, d.Id()),
-			Timeout:      d.Timeout(schema.TimeoutDelete),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
 			Delay:        10 * time.Second,
 			MinTimeout:   5 * time.Second,
 			PollInterval: 2 * time.Second,
@@ -269,7 +270,7 @@ func resourceSFSTurboShareV1Update(ctx context.Context, d *schema.ResourceData,
 			Pending:      []string{"121"},
 			Target:       []string{"221", "232"},
 			Refresh:      waitForSFSTurboSubStatus(client, d.Id()),
-			Timeout:      d.Timeout(schema.TimeoutDelete),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
 			Delay:        10 * time.Second,
 			MinTimeout:   5 * time.Second,
 			PollInterval: 2 * time.Second,
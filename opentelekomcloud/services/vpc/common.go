@@ -1,5 +1,10 @@
 package vpc
 
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
+)
+
 const (
 	errCreationV3Client = "error creating OpenTelekomCloud NetworkingV3 client: %w"
 	errCreationV2Client = "error creating OpenTelekomCloud NetworkingV2 client: %w"
@@ -8,4 +13,29 @@ const (
 	keyClientV1         = "vpc-v1-client"
 	// MaxCreateRoutes is the limitation of creating API
 	MaxCreateRoutes int = 5
+	// MaxBulkSecGroupRules is the limitation of rules created by single request
+	MaxBulkSecGroupRules int = 50
 )
+
+// secGroupRulesBulkCreate creates multiple security group rules with a single request
+func secGroupRulesBulkCreate(client *golangsdk.ServiceClient, opts []rules.CreateOpts) ([]rules.SecGroupRule, error) {
+	items := make([]map[string]interface{}, len(opts))
+	for i, opt := range opts {
+		b, err := golangsdk.BuildRequestBody(opt, "")
+		if err != nil {
+			return nil, err
+		}
+		items[i] = b
+	}
+	body := map[string]interface{}{
+		"security_group_rules": items,
+	}
+
+	var res struct {
+		Rules []rules.SecGroupRule `json:"security_group_rules"`
+	}
+	_, err := client.Post(client.ServiceURL("security-group-rules"), body, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return res.Rules, err
+}
@@ -0,0 +1,204 @@
+package vpc
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/eips"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceVPCEipsV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVPCEipsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"port_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"bandwidth_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ip_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice([]int{4, 6}),
+			},
+			"tags": common.TagsSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"eips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bandwidth_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bandwidth_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bandwidth_share_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVPCEipsV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV1Client, err)
+	}
+	networkingV2Client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	listOpts := eips.ListOpts{
+		Status:      d.Get("status").(string),
+		PortID:      d.Get("port_id").(string),
+		BandwidthID: d.Get("bandwidth_id").(string),
+	}
+	allEIPs, err := eips.List(client, listOpts)
+	if err != nil {
+		return fmterr.Errorf("unable to retrieve EIPs: %w", err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	ipVersion := d.Get("ip_version").(int)
+	tagList := common.ExpandResourceTags(d.Get("tags").(map[string]interface{}))
+
+	var ids []string
+	var result []map[string]interface{}
+	for _, eip := range allEIPs {
+		if nameRegex != nil && !nameRegex.MatchString(eip.Name) {
+			continue
+		}
+		if ipVersion != 0 && eip.IpVersion != ipVersion {
+			continue
+		}
+
+		var resourceTags []tags.ResourceTag
+		if len(tagList) > 0 {
+			resourceTags, err = tags.Get(networkingV2Client, "publicips", eip.ID).Extract()
+			if err != nil {
+				return fmterr.Errorf("error fetching OpenTelekomCloud VPC EIP tags: %w", err)
+			}
+			if !common.ContainsAllTags(resourceTags, tagList) {
+				continue
+			}
+		}
+
+		ids = append(ids, eip.ID)
+		result = append(result, map[string]interface{}{
+			"id":                   eip.ID,
+			"name":                 eip.Name,
+			"status":               eip.Status,
+			"type":                 eip.Type,
+			"public_ip_address":    eip.PublicAddress,
+			"private_ip_address":   eip.PrivateAddress,
+			"port_id":              eip.PortID,
+			"ip_version":           eip.IpVersion,
+			"bandwidth_id":         eip.BandwidthID,
+			"bandwidth_size":       eip.BandwidthSize,
+			"bandwidth_share_type": eip.BandwidthShareType,
+			"create_time":          eip.CreateTime,
+			"tags":                 common.TagsToMap(resourceTags),
+		})
+	}
+
+	log.Printf("[DEBUG] Retrieved %d EIPs using given filter", len(ids))
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("ids", ids),
+		d.Set("eips", result),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,380 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/pointerto"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/lts/v2/streams"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/flowlogs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+// flowLogQueryPageSize is the number of LTS log events requested at once
+const flowLogQueryPageSize = 1000
+
+func DataSourceVpcFlowLogRecordsV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVpcFlowLogRecordsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"flow_log_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"flow_log_id", "log_group_id"},
+			},
+			"log_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"log_topic_id"},
+			},
+			"log_topic_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"start_time": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.IsRFC3339Time,
+				ConflictsWith: []string{"time_range"},
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"time_range": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "1h",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+					if _, err := time.ParseDuration(v.(string)); err != nil {
+						errs = append(errs, fmt.Errorf("%q must be a valid duration, e.g. `15m` or `1h`: %s", k, err))
+					}
+					return
+				},
+			},
+			"source_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"destination_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"source_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"destination_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"tcp", "udp", "icmp",
+				}, true),
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ACCEPT", "REJECT",
+				}, true),
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 5000),
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"destination_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"packets": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"log_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"raw": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flowLogRecord is the parsed VPC flow log record in the default format:
+// `version project-id interface-id srcaddr dstaddr srcport dstport protocol packets bytes start end action log-status`
+type flowLogRecord struct {
+	InterfaceID     string
+	SourceIP        string
+	DestinationIP   string
+	SourcePort      int
+	DestinationPort int
+	Protocol        string
+	Packets         int
+	Bytes           int
+	StartTime       time.Time
+	EndTime         time.Time
+	Action          string
+	LogStatus       string
+	Raw             string
+}
+
+var flowLogProtocols = map[string]string{
+	"1":  "icmp",
+	"6":  "tcp",
+	"17": "udp",
+}
+
+func parseFlowLogRecord(content string) (*flowLogRecord, error) {
+	fields := strings.Fields(content)
+	if len(fields) != 14 {
+		return nil, fmt.Errorf("unexpected number of fields in flow log record: %d", len(fields))
+	}
+
+	ints := make([]int64, 0, 6)
+	for _, i := range []int{5, 6, 8, 9, 10, 11} {
+		// `-` is used for the fields without data, e.g. in NODATA records
+		if fields[i] == "-" {
+			ints = append(ints, 0)
+			continue
+		}
+		v, err := strconv.ParseInt(fields[i], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid numeric field %q in flow log record: %w", fields[i], err)
+		}
+		ints = append(ints, v)
+	}
+
+	protocol := fields[7]
+	if name, ok := flowLogProtocols[protocol]; ok {
+		protocol = name
+	}
+
+	return &flowLogRecord{
+		InterfaceID:     fields[2],
+		SourceIP:        fields[3],
+		DestinationIP:   fields[4],
+		SourcePort:      int(ints[0]),
+		DestinationPort: int(ints[1]),
+		Protocol:        protocol,
+		Packets:         int(ints[2]),
+		Bytes:           int(ints[3]),
+		StartTime:       time.Unix(ints[4], 0).UTC(),
+		EndTime:         time.Unix(ints[5], 0).UTC(),
+		Action:          fields[12],
+		LogStatus:       fields[13],
+		Raw:             content,
+	}, nil
+}
+
+func flowLogRecordMatches(d *schema.ResourceData, r *flowLogRecord) bool {
+	if v := d.Get("source_ip").(string); v != "" && v != r.SourceIP {
+		return false
+	}
+	if v := d.Get("destination_ip").(string); v != "" && v != r.DestinationIP {
+		return false
+	}
+	if v := d.Get("source_port").(int); v != 0 && v != r.SourcePort {
+		return false
+	}
+	if v := d.Get("destination_port").(int); v != 0 && v != r.DestinationPort {
+		return false
+	}
+	if v := d.Get("protocol").(string); v != "" && !strings.EqualFold(v, r.Protocol) {
+		return false
+	}
+	if v := d.Get("action").(string); v != "" && !strings.EqualFold(v, r.Action) {
+		return false
+	}
+	return true
+}
+
+func flowLogQueryWindow(d *schema.ResourceData) (time.Time, time.Time) {
+	end := time.Now().UTC()
+	if v, ok := d.GetOk("end_time"); ok {
+		end, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	if v, ok := d.GetOk("start_time"); ok {
+		start, _ := time.Parse(time.RFC3339, v.(string))
+		return start, end
+	}
+	timeRange, _ := time.ParseDuration(d.Get("time_range").(string))
+	return end.Add(-timeRange), end
+}
+
+func dataSourceVpcFlowLogRecordsV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+
+	groupID := d.Get("log_group_id").(string)
+	topicID := d.Get("log_topic_id").(string)
+	if flowLogID := d.Get("flow_log_id").(string); flowLogID != "" {
+		client, err := config.NetworkingV1Client(region)
+		if err != nil {
+			return fmterr.Errorf(errCreationV1Client, err)
+		}
+		fl, err := flowlogs.Get(client, flowLogID).Extract()
+		if err != nil {
+			return fmterr.Errorf("error fetching VPC flow log (%s): %w", flowLogID, err)
+		}
+		groupID, topicID = fl.LogGroupID, fl.LogTopicID
+	}
+
+	client, err := config.LtsV2Client(region)
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud LTS client: %w", err)
+	}
+
+	start, end := flowLogQueryWindow(d)
+	if !start.Before(end) {
+		return fmterr.Errorf("query start time (%s) must be before end time (%s)",
+			start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+
+	limit := d.Get("limit").(int)
+	opts := streams.ListLogsOpts{
+		GroupId:   groupID,
+		StreamId:  topicID,
+		StartTime: strconv.FormatInt(start.UnixMilli(), 10),
+		EndTime:   strconv.FormatInt(end.UnixMilli(), 10),
+		// the most recent records first
+		IsDesc: pointerto.Bool(true),
+		Limit:  flowLogQueryPageSize,
+	}
+
+	var ids []string
+	var records []map[string]interface{}
+	for len(records) < limit {
+		resp, err := streams.ListLogs(client, opts)
+		if err != nil {
+			return fmterr.Errorf("error querying flow log records from LTS topic (%s): %w", topicID, err)
+		}
+
+		for _, event := range resp.Logs {
+			record, err := parseFlowLogRecord(event.Content)
+			if err != nil {
+				log.Printf("[WARN] Skipping flow log record (%s): %s", event.LineNum, err)
+				continue
+			}
+			if !flowLogRecordMatches(d, record) {
+				continue
+			}
+
+			ids = append(ids, event.LineNum)
+			records = append(records, map[string]interface{}{
+				"interface_id":     record.InterfaceID,
+				"source_ip":        record.SourceIP,
+				"destination_ip":   record.DestinationIP,
+				"source_port":      record.SourcePort,
+				"destination_port": record.DestinationPort,
+				"protocol":         record.Protocol,
+				"packets":          record.Packets,
+				"bytes":            record.Bytes,
+				"start_time":       record.StartTime.Format(time.RFC3339),
+				"end_time":         record.EndTime.Format(time.RFC3339),
+				"action":           record.Action,
+				"log_status":       record.LogStatus,
+				"raw":              record.Raw,
+			})
+			if len(records) == limit {
+				break
+			}
+		}
+
+		if len(resp.Logs) < flowLogQueryPageSize {
+			break
+		}
+		opts.LineNum = resp.Logs[len(resp.Logs)-1].LineNum
+		opts.SearchType = "forwards"
+	}
+
+	log.Printf("[DEBUG] Retrieved %d flow log records using given filter", len(records))
+	d.SetId(hashcode.Strings(append([]string{groupID, topicID}, ids...)))
+
+	mErr := multierror.Append(
+		d.Set("region", region),
+		d.Set("log_group_id", groupID),
+		d.Set("log_topic_id", topicID),
+		d.Set("records", records),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package vpc
+
+import (
+	"testing"
+	"time"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestParseFlowLogRecord(t *testing.T) {
+	cases := []struct {
+		name     string
+		content  string
+		expected flowLogRecord
+	}{
+		{
+			name:    "tcp accept",
+			content: "1 5f67944957444bd6bb4fe3b367de8f3d 1d515d18-1b36-47dc-a983-bd6512aed4bd 192.168.0.154 192.168.3.25 38929 53 6 1 96 1548752136 1548752736 ACCEPT OK",
+			expected: flowLogRecord{
+				InterfaceID:     "1d515d18-1b36-47dc-a983-bd6512aed4bd",
+				SourceIP:        "192.168.0.154",
+				DestinationIP:   "192.168.3.25",
+				SourcePort:      38929,
+				DestinationPort: 53,
+				Protocol:        "tcp",
+				Packets:         1,
+				Bytes:           96,
+				StartTime:       time.Unix(1548752136, 0).UTC(),
+				EndTime:         time.Unix(1548752736, 0).UTC(),
+				Action:          "ACCEPT",
+				LogStatus:       "OK",
+			},
+		},
+		{
+			name:    "udp reject",
+			content: "1 5f67944957444bd6bb4fe3b367de8f3d 1d515d18-1b36-47dc-a983-bd6512aed4bd 10.0.0.1 10.0.0.2 5353 5353 17 4 512 1548752136 1548752736 REJECT OK",
+			expected: flowLogRecord{
+				InterfaceID:     "1d515d18-1b36-47dc-a983-bd6512aed4bd",
+				SourceIP:        "10.0.0.1",
+				DestinationIP:   "10.0.0.2",
+				SourcePort:      5353,
+				DestinationPort: 5353,
+				Protocol:        "udp",
+				Packets:         4,
+				Bytes:           512,
+				StartTime:       time.Unix(1548752136, 0).UTC(),
+				EndTime:         time.Unix(1548752736, 0).UTC(),
+				Action:          "REJECT",
+				LogStatus:       "OK",
+			},
+		},
+		{
+			name:    "icmp without ports",
+			content: "1 5f67944957444bd6bb4fe3b367de8f3d 1d515d18-1b36-47dc-a983-bd6512aed4bd 10.0.0.1 10.0.0.2 - - 1 2 168 1548752136 1548752736 ACCEPT OK",
+			expected: flowLogRecord{
+				InterfaceID:   "1d515d18-1b36-47dc-a983-bd6512aed4bd",
+				SourceIP:      "10.0.0.1",
+				DestinationIP: "10.0.0.2",
+				Protocol:      "icmp",
+				Packets:       2,
+				Bytes:         168,
+				StartTime:     time.Unix(1548752136, 0).UTC(),
+				EndTime:       time.Unix(1548752736, 0).UTC(),
+				Action:        "ACCEPT",
+				LogStatus:     "OK",
+			},
+		},
+		{
+			name:    "unknown protocol number",
+			content: "1 5f67944957444bd6bb4fe3b367de8f3d 1d515d18-1b36-47dc-a983-bd6512aed4bd 10.0.0.1 10.0.0.2 - - 47 3 300 1548752136 1548752736 ACCEPT OK",
+			expected: flowLogRecord{
+				InterfaceID:   "1d515d18-1b36-47dc-a983-bd6512aed4bd",
+				SourceIP:      "10.0.0.1",
+				DestinationIP: "10.0.0.2",
+				Protocol:      "47",
+				Packets:       3,
+				Bytes:         300,
+				StartTime:     time.Unix(1548752136, 0).UTC(),
+				EndTime:       time.Unix(1548752736, 0).UTC(),
+				Action:        "ACCEPT",
+				LogStatus:     "OK",
+			},
+		},
+		{
+			name:    "NODATA",
+			content: "1 5f67944957444bd6bb4fe3b367de8f3d 1d515d18-1b36-47dc-a983-bd6512aed4bd - - - - - - - 1548752136 1548752736 - NODATA",
+			expected: flowLogRecord{
+				InterfaceID:   "1d515d18-1b36-47dc-a983-bd6512aed4bd",
+				SourceIP:      "-",
+				DestinationIP: "-",
+				Protocol:      "-",
+				StartTime:     time.Unix(1548752136, 0).UTC(),
+				EndTime:       time.Unix(1548752736, 0).UTC(),
+				Action:        "-",
+				LogStatus:     "NODATA",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			record, err := parseFlowLogRecord(c.content)
+			th.AssertNoErr(t, err)
+			c.expected.Raw = c.content
+			th.AssertDeepEquals(t, c.expected, *record)
+		})
+	}
+}
+
+func TestParseFlowLogRecordErrors(t *testing.T) {
+	cases := map[string]string{
+		"too few fields":     "1 5f67944957444bd6bb4fe3b367de8f3d 1d515d18-1b36-47dc-a983-bd6512aed4bd - - - - - - - 1548752136 1548752736 NODATA",
+		"non-numeric port":   "1 5f67944957444bd6bb4fe3b367de8f3d 1d515d18-1b36-47dc-a983-bd6512aed4bd 10.0.0.1 10.0.0.2 http 53 6 1 96 1548752136 1548752736 ACCEPT OK",
+		"non-numeric period": "1 5f67944957444bd6bb4fe3b367de8f3d 1d515d18-1b36-47dc-a983-bd6512aed4bd 10.0.0.1 10.0.0.2 38929 53 6 1 96 start 1548752736 ACCEPT OK",
+	}
+
+	for name, content := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseFlowLogRecord(content); err == nil {
+				t.Fatalf("expected error for %q", content)
+			}
+		})
+	}
+}
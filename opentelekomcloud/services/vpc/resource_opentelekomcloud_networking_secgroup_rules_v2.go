@@ -0,0 +1,529 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceNetworkingSecGroupRulesV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingSecGroupRulesV2Create,
+		ReadContext:   resourceNetworkingSecGroupRulesV2Read,
+		UpdateContext: resourceNetworkingSecGroupRulesV2Update,
+		DeleteContext: resourceNetworkingSecGroupRulesV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNetworkingSecGroupRulesV2Import,
+		},
+
+		CustomizeDiff: validateSecGroupRules,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"direction": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ingress", "egress",
+							}, false),
+						},
+						"ethertype": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "IPv4",
+							ValidateFunc: validation.StringInSlice([]string{
+								"IPv4", "IPv6",
+							}, false),
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ports": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateSecGroupRulePorts,
+						},
+						"remote_ip_prefixes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsCIDR,
+								StateFunc: func(v interface{}) string {
+									return strings.ToLower(v.(string))
+								},
+							},
+						},
+						"remote_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// secGroupRuleKey identifies single API security group rule,
+// PortRangeMax can be unset for ICMP rule matching any code of the type
+type secGroupRuleKey struct {
+	Direction       string
+	EtherType       string
+	Protocol        string
+	HasPorts        bool
+	HasPortRangeMax bool
+	PortRangeMin    int
+	PortRangeMax    int
+	RemoteIPPrefix  string
+	RemoteGroupID   string
+	Description     string
+}
+
+func (k secGroupRuleKey) createOpts(secGroupID string) rules.CreateOpts {
+	opts := rules.CreateOpts{
+		Direction:      resourceNetworkingSecGroupRuleV2DetermineDirection(k.Direction),
+		EtherType:      resourceNetworkingSecGroupRuleV2DetermineEtherType(k.EtherType),
+		SecGroupID:     secGroupID,
+		Protocol:       resourceNetworkingSecGroupRuleV2DetermineProtocol(k.Protocol),
+		RemoteIPPrefix: k.RemoteIPPrefix,
+		RemoteGroupID:  k.RemoteGroupID,
+		Description:    k.Description,
+	}
+	if k.HasPorts {
+		portRangeMin := k.PortRangeMin
+		opts.PortRangeMin = &portRangeMin
+	}
+	if k.HasPortRangeMax {
+		portRangeMax := k.PortRangeMax
+		opts.PortRangeMax = &portRangeMax
+	}
+	return opts
+}
+
+func secGroupRuleKeyFromAPI(rule rules.SecGroupRule) secGroupRuleKey {
+	key := secGroupRuleKey{
+		Direction:      rule.Direction,
+		EtherType:      rule.EtherType,
+		Protocol:       rule.Protocol,
+		RemoteIPPrefix: strings.ToLower(rule.RemoteIPPrefix),
+		RemoteGroupID:  rule.RemoteGroupID,
+		Description:    rule.Description,
+	}
+	if rule.PortRangeMin != nil {
+		key.HasPorts = true
+		key.PortRangeMin = *rule.PortRangeMin
+	}
+	if rule.PortRangeMax != nil {
+		key.HasPorts = true
+		key.HasPortRangeMax = true
+		key.PortRangeMax = *rule.PortRangeMax
+	}
+	return key
+}
+
+type secGroupPortRange struct {
+	set      bool
+	min, max int
+	// hasMax is false for the single ICMP type without code
+	hasMax bool
+}
+
+// splitSecGroupRulePorts parses `ports` syntax without checking the values
+func splitSecGroupRulePorts(ports string) ([]secGroupPortRange, error) {
+	if ports == "" {
+		return []secGroupPortRange{{}}, nil
+	}
+	var ranges []secGroupPortRange
+	for _, part := range strings.Split(ports, ",") {
+		part = strings.TrimSpace(part)
+		bounds := strings.SplitN(part, "-", 2)
+		portMin, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q", part)
+		}
+		portMax := portMin
+		if len(bounds) == 2 {
+			portMax, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid port range %q", part)
+			}
+		}
+		ranges = append(ranges, secGroupPortRange{set: true, min: portMin, max: portMax, hasMax: len(bounds) == 2})
+	}
+	return ranges, nil
+}
+
+// parseSecGroupRulePorts parses `ports` of the rule with given protocol.
+// For `icmp` each entry is `type-code` pair, as port fields hold ICMP type and code,
+// the single value is the ICMP type with any code.
+func parseSecGroupRulePorts(ports, protocol string) ([]secGroupPortRange, error) {
+	ranges, err := splitSecGroupRulePorts(ports)
+	if err != nil {
+		return nil, err
+	}
+	for i, r := range ranges {
+		if !r.set {
+			continue
+		}
+		if protocol == "icmp" {
+			if !r.hasMax {
+				ranges[i].max = 0
+			}
+			if r.min < 0 || r.min > 255 || ranges[i].max < 0 || ranges[i].max > 255 {
+				return nil, fmt.Errorf("invalid ICMP type-code %q, expected values between 0 and 255", formatSecGroupPortRange(r))
+			}
+			continue
+		}
+		ranges[i].hasMax = true
+		if r.min < 1 || r.max > 65535 || r.min > r.max {
+			return nil, fmt.Errorf("invalid port range %d-%d, expected values between 1 and 65535", r.min, r.max)
+		}
+	}
+	return ranges, nil
+}
+
+// formatSecGroupPortRange is the reverse of splitSecGroupRulePorts for the single range
+func formatSecGroupPortRange(r secGroupPortRange) string {
+	if !r.hasMax {
+		return strconv.Itoa(r.min)
+	}
+	return fmt.Sprintf("%d-%d", r.min, r.max)
+}
+
+func validateSecGroupRulePorts(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := splitSecGroupRulePorts(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+	return
+}
+
+func validateSecGroupRules(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("rule") {
+		return nil
+	}
+	for _, raw := range d.Get("rule").(*schema.Set).List() {
+		if _, err := expandSecGroupRuleBlock(raw.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expandSecGroupRuleBlock expands single `rule` block to the list of API rules:
+// one per each combination of port range and remote IP prefix
+func expandSecGroupRuleBlock(raw map[string]interface{}) ([]secGroupRuleKey, error) {
+	ranges, err := parseSecGroupRulePorts(raw["ports"].(string), raw["protocol"].(string))
+	if err != nil {
+		return nil, err
+	}
+	prefixes := []string{""}
+	if set, ok := raw["remote_ip_prefixes"].(*schema.Set); ok && set.Len() > 0 {
+		prefixes = common.ExpandToStringListBySet(set)
+		for i, prefix := range prefixes {
+			prefixes[i] = strings.ToLower(prefix)
+		}
+	}
+
+	var keys []secGroupRuleKey
+	for _, portRange := range ranges {
+		for _, prefix := range prefixes {
+			keys = append(keys, secGroupRuleKey{
+				Direction:       raw["direction"].(string),
+				EtherType:       raw["ethertype"].(string),
+				Protocol:        raw["protocol"].(string),
+				HasPorts:        portRange.set,
+				HasPortRangeMax: portRange.hasMax,
+				PortRangeMin:    portRange.min,
+				PortRangeMax:    portRange.max,
+				RemoteIPPrefix:  prefix,
+				RemoteGroupID:   raw["remote_group_id"].(string),
+				Description:     raw["description"].(string),
+			})
+		}
+	}
+	return keys, nil
+}
+
+func expandSecGroupRules(set *schema.Set) (map[secGroupRuleKey]bool, error) {
+	keys := make(map[secGroupRuleKey]bool)
+	for _, raw := range set.List() {
+		expanded, err := expandSecGroupRuleBlock(raw.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range expanded {
+			keys[key] = true
+		}
+	}
+	return keys, nil
+}
+
+func listSecGroupRules(client *golangsdk.ServiceClient, secGroupID string) ([]rules.SecGroupRule, error) {
+	pages, err := rules.List(client, rules.ListOpts{SecGroupID: secGroupID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return rules.ExtractRules(pages)
+}
+
+// applySecGroupRules makes security group rules to match the desired set
+func applySecGroupRules(client *golangsdk.ServiceClient, secGroupID string, desired map[secGroupRuleKey]bool) error {
+	existing, err := listSecGroupRules(client, secGroupID)
+	if err != nil {
+		return fmt.Errorf("error listing security group rules: %w", err)
+	}
+
+	present := make(map[secGroupRuleKey]bool)
+	for _, rule := range existing {
+		key := secGroupRuleKeyFromAPI(rule)
+		if desired[key] && !present[key] {
+			present[key] = true
+			continue
+		}
+		log.Printf("[DEBUG] Deleting security group rule %s of group %s", rule.ID, secGroupID)
+		if err := rules.Delete(client, rule.ID).ExtractErr(); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return fmt.Errorf("error deleting security group rule %s: %w", rule.ID, err)
+		}
+	}
+
+	var toCreate []rules.CreateOpts
+	for key := range desired {
+		if !present[key] {
+			toCreate = append(toCreate, key.createOpts(secGroupID))
+		}
+	}
+	for start := 0; start < len(toCreate); start += MaxBulkSecGroupRules {
+		end := start + MaxBulkSecGroupRules
+		if end > len(toCreate) {
+			end = len(toCreate)
+		}
+		log.Printf("[DEBUG] Creating %d security group rules in group %s", end-start, secGroupID)
+		if _, err := secGroupRulesBulkCreate(client, toCreate[start:end]); err != nil {
+			return fmt.Errorf("error creating security group rules: %w", err)
+		}
+	}
+	return nil
+}
+
+func resourceNetworkingSecGroupRulesV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.NetworkingV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	secGroupID := d.Get("security_group_id").(string)
+	desired, err := expandSecGroupRules(d.Get("rule").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	osMutexKV.Lock(secGroupID)
+	defer osMutexKV.Unlock(secGroupID)
+
+	if err := applySecGroupRules(client, secGroupID, desired); err != nil {
+		return fmterr.Errorf("error managing rules of OpenTelekomCloud security group %s: %w", secGroupID, err)
+	}
+	d.SetId(secGroupID)
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV2)
+	return resourceNetworkingSecGroupRulesV2Read(clientCtx, d, meta)
+}
+
+func resourceNetworkingSecGroupRulesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.NetworkingV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	if _, err := groups.Get(client, d.Id()).Extract(); err != nil {
+		return common.CheckDeletedDiag(d, err, "OpenTelekomCloud Security Group")
+	}
+
+	existing, err := listSecGroupRules(client, d.Id())
+	if err != nil {
+		return fmterr.Errorf("error listing rules of OpenTelekomCloud security group %s: %w", d.Id(), err)
+	}
+
+	actual := make(map[secGroupRuleKey]bool)
+	for _, rule := range existing {
+		actual[secGroupRuleKeyFromAPI(rule)] = true
+	}
+
+	// keep blocks from the state which are fully present remotely,
+	// all other remote rules are reported as separate blocks to show the drift
+	var ruleBlocks []interface{}
+	matched := make(map[secGroupRuleKey]bool)
+	for _, raw := range d.Get("rule").(*schema.Set).List() {
+		block := raw.(map[string]interface{})
+		keys, err := expandSecGroupRuleBlock(block)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		complete := true
+		for _, key := range keys {
+			if !actual[key] {
+				complete = false
+				break
+			}
+		}
+		if !complete {
+			continue
+		}
+		for _, key := range keys {
+			matched[key] = true
+		}
+		ruleBlocks = append(ruleBlocks, block)
+	}
+
+	var unmanaged []secGroupRuleKey
+	for key := range actual {
+		if !matched[key] {
+			unmanaged = append(unmanaged, key)
+		}
+	}
+	sort.Slice(unmanaged, func(i, j int) bool {
+		return fmt.Sprintf("%+v", unmanaged[i]) < fmt.Sprintf("%+v", unmanaged[j])
+	})
+	for _, key := range unmanaged {
+		ruleBlocks = append(ruleBlocks, flattenSecGroupRuleKey(key))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("security_group_id", d.Id()),
+		d.Set("rule", ruleBlocks),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenSecGroupRuleKey(key secGroupRuleKey) map[string]interface{} {
+	ports := ""
+	if key.HasPorts {
+		ports = formatSecGroupPortRange(secGroupPortRange{
+			set:    true,
+			min:    key.PortRangeMin,
+			max:    key.PortRangeMax,
+			hasMax: key.HasPortRangeMax && (key.PortRangeMax != key.PortRangeMin || key.Protocol == "icmp"),
+		})
+	}
+	var prefixes []interface{}
+	if key.RemoteIPPrefix != "" {
+		prefixes = append(prefixes, key.RemoteIPPrefix)
+	}
+	return map[string]interface{}{
+		"direction":          key.Direction,
+		"ethertype":          key.EtherType,
+		"protocol":           key.Protocol,
+		"ports":              ports,
+		"remote_ip_prefixes": prefixes,
+		"remote_group_id":    key.RemoteGroupID,
+		"description":        key.Description,
+	}
+}
+
+func resourceNetworkingSecGroupRulesV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.NetworkingV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	if d.HasChange("rule") {
+		desired, err := expandSecGroupRules(d.Get("rule").(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		osMutexKV.Lock(d.Id())
+		defer osMutexKV.Unlock(d.Id())
+
+		if err := applySecGroupRules(client, d.Id(), desired); err != nil {
+			return fmterr.Errorf("error managing rules of OpenTelekomCloud security group %s: %w", d.Id(), err)
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV2)
+	return resourceNetworkingSecGroupRulesV2Read(clientCtx, d, meta)
+}
+
+func resourceNetworkingSecGroupRulesV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV2, func() (*golangsdk.ServiceClient, error) {
+		return config.NetworkingV2Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	osMutexKV.Lock(d.Id())
+	defer osMutexKV.Unlock(d.Id())
+
+	if _, err := groups.Get(client, d.Id()).Extract(); err != nil {
+		return common.CheckDeletedDiag(d, err, "OpenTelekomCloud Security Group")
+	}
+
+	if err := applySecGroupRules(client, d.Id(), nil); err != nil {
+		return fmterr.Errorf("error deleting rules of OpenTelekomCloud security group %s: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceNetworkingSecGroupRulesV2Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("security_group_id", d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,118 @@
+package vpc
+
+import (
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestSplitSecGroupRulePorts(t *testing.T) {
+	ranges, err := splitSecGroupRulePorts("")
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []secGroupPortRange{{}}, ranges)
+
+	ranges, err = splitSecGroupRulePorts("22, 8000 - 8080,0-0")
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []secGroupPortRange{
+		{set: true, min: 22, max: 22},
+		{set: true, min: 8000, max: 8080, hasMax: true},
+		{set: true, min: 0, max: 0, hasMax: true},
+	}, ranges)
+
+	for _, ports := range []string{"ssh", "22,", "80-http", "-1"} {
+		if _, err := splitSecGroupRulePorts(ports); err == nil {
+			t.Fatalf("expected error for %q", ports)
+		}
+	}
+}
+
+func TestParseSecGroupRulePorts(t *testing.T) {
+	cases := []struct {
+		name     string
+		ports    string
+		protocol string
+		expected []secGroupPortRange
+	}{
+		{
+			name:     "all ports",
+			protocol: "tcp",
+			expected: []secGroupPortRange{{}},
+		},
+		{
+			name:     "tcp single port and range",
+			ports:    "80,8000-8080",
+			protocol: "tcp",
+			expected: []secGroupPortRange{
+				{set: true, min: 80, max: 80, hasMax: true},
+				{set: true, min: 8000, max: 8080, hasMax: true},
+			},
+		},
+		{
+			name:     "icmp type without code",
+			ports:    "8",
+			protocol: "icmp",
+			expected: []secGroupPortRange{
+				{set: true, min: 8},
+			},
+		},
+		{
+			name:     "icmp type and code",
+			ports:    "0-0,8-8",
+			protocol: "icmp",
+			expected: []secGroupPortRange{
+				{set: true, min: 0, max: 0, hasMax: true},
+				{set: true, min: 8, max: 8, hasMax: true},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ranges, err := parseSecGroupRulePorts(c.ports, c.protocol)
+			th.AssertNoErr(t, err)
+			th.AssertDeepEquals(t, c.expected, ranges)
+		})
+	}
+}
+
+func TestParseSecGroupRulePortsErrors(t *testing.T) {
+	cases := map[string][2]string{
+		"tcp zero port":       {"0", "tcp"},
+		"tcp port too big":    {"65536", "udp"},
+		"tcp reversed range":  {"8080-8000", "tcp"},
+		"icmp type too big":   {"256", "icmp"},
+		"icmp code too big":   {"8-256", "icmp"},
+		"invalid port syntax": {"http", "tcp"},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseSecGroupRulePorts(c[0], c[1]); err == nil {
+				t.Fatalf("expected error for %q of %s", c[0], c[1])
+			}
+		})
+	}
+}
+
+func TestFlattenSecGroupRuleKeyPorts(t *testing.T) {
+	cases := map[string]secGroupRuleKey{
+		"":     {Protocol: "tcp"},
+		"80":   {Protocol: "tcp", HasPorts: true, HasPortRangeMax: true, PortRangeMin: 80, PortRangeMax: 80},
+		"8-80": {Protocol: "tcp", HasPorts: true, HasPortRangeMax: true, PortRangeMin: 8, PortRangeMax: 80},
+		"8":    {Protocol: "icmp", HasPorts: true, PortRangeMin: 8},
+		"8-8":  {Protocol: "icmp", HasPorts: true, HasPortRangeMax: true, PortRangeMin: 8, PortRangeMax: 8},
+		"8-0":  {Protocol: "icmp", HasPorts: true, HasPortRangeMax: true, PortRangeMin: 8},
+	}
+
+	for ports, key := range cases {
+		th.AssertEquals(t, ports, flattenSecGroupRuleKey(key)["ports"])
+
+		// flattened ports are expanded back to the same range
+		ranges, err := parseSecGroupRulePorts(ports, key.Protocol)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, key.HasPorts, ranges[0].set)
+		th.AssertEquals(t, key.HasPortRangeMax, ranges[0].hasMax)
+		th.AssertEquals(t, key.PortRangeMin, ranges[0].min)
+		th.AssertEquals(t, key.PortRangeMax, ranges[0].max)
+	}
+}
@@ -4,15 +4,19 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/bandwidths"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/eips"
+	bandwidthsv2 "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/bandwidths"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
@@ -34,6 +38,9 @@ func ResourceVpcEIPV1() *schema.Resource {
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
+
+		CustomizeDiff: validateEIPBandwidth,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -70,6 +77,13 @@ func ResourceVpcEIPV1() *schema.Resource {
 							Computed: true,
 							ForceNew: true,
 						},
+						"ip_version": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntInSlice([]int{4, 6}),
+						},
 					},
 				},
 			},
@@ -80,18 +94,24 @@ func ResourceVpcEIPV1() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
 						"name": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
+							Computed: true,
 						},
 						"size": {
 							Type:     schema.TypeInt,
-							Required: true,
+							Optional: true,
+							Computed: true,
 						},
 						"share_type": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"charge_mode": {
 							Type:     schema.TypeString,
@@ -178,12 +198,13 @@ func resourceVpcEIPV1Read(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	// Set public ip
-	publicIP := []map[string]string{
+	publicIP := []map[string]interface{}{
 		{
 			"type":       eip.Type,
 			"ip_address": eip.PublicAddress,
 			"port_id":    eip.PortID,
 			"name":       eip.Name,
+			"ip_version": eip.IpVersion,
 		},
 	}
 	if err := d.Set("publicip", publicIP); err != nil {
@@ -193,6 +214,7 @@ func resourceVpcEIPV1Read(ctx context.Context, d *schema.ResourceData, meta inte
 	// Set bandwidth
 	bw := []map[string]interface{}{
 		{
+			"id":          eip.BandwidthID,
 			"name":        bandWidth.Name,
 			"size":        eip.BandwidthSize,
 			"share_type":  eip.BandwidthShareType,
@@ -222,8 +244,18 @@ func resourceVpcEIPV1Update(ctx context.Context, d *schema.ResourceData, meta in
 		return fmterr.Errorf(errCreationV1Client, err)
 	}
 
-	// Update bandwidth change
-	if d.HasChange("bandwidth") {
+	// Move EIP between dedicated and shared bandwidths
+	migrated := false
+	if d.HasChanges("bandwidth.0.share_type", "bandwidth.0.id") {
+		migrated, err = migrateEIPBandwidth(d, client, config)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Update bandwidth change, shared bandwidth is not changed after migration
+	shareType := d.Get("bandwidth.0.share_type").(string)
+	if d.HasChanges("bandwidth.0.name", "bandwidth.0.size") && !(migrated && shareType == "WHOLE") {
 		var updateOpts bandwidths.UpdateOpts
 
 		newBWList := d.Get("bandwidth").([]interface{})
@@ -325,6 +357,9 @@ func resourcePublicIP(d *schema.ResourceData) eips.PublicIpOpts {
 		Type:    publicIPRaw["type"].(string),
 		Address: publicIPRaw["ip_address"].(string),
 	}
+	if v := publicIPRaw["ip_version"].(int); v != 0 {
+		publicIpOpts.Version = strconv.Itoa(v)
+	}
 	return publicIpOpts
 }
 
@@ -337,9 +372,89 @@ func resourceBandWidth(d *schema.ResourceData) eips.BandwidthOpts {
 		ShareType:  bandwidthRaw["share_type"].(string),
 		ChargeMode: bandwidthRaw["charge_mode"].(string),
 	}
+	// EIP is added to the existing bandwidth
+	if bandwidthOpts.ShareType == "WHOLE" {
+		bandwidthOpts.Id = bandwidthRaw["id"].(string)
+	}
 	return bandwidthOpts
 }
 
+// validateEIPBandwidth checks that dedicated bandwidth has `name` and `size` set in the configuration,
+// as these values are computed only for the shared bandwidth
+func validateEIPBandwidth(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	bandwidth := d.GetRawConfig().GetAttr("bandwidth")
+	if !bandwidth.IsKnown() || bandwidth.IsNull() || bandwidth.LengthInt() == 0 {
+		return nil
+	}
+	block := bandwidth.Index(cty.NumberIntVal(0))
+	shareType := block.GetAttr("share_type")
+	if !shareType.IsKnown() || shareType.IsNull() || shareType.AsString() != "PER" {
+		return nil
+	}
+	for _, attr := range []string{"name", "size"} {
+		if block.GetAttr(attr).IsNull() {
+			return fmt.Errorf("bandwidth.0.%s is required for the dedicated (PER) bandwidth", attr)
+		}
+	}
+	return nil
+}
+
+// migrateEIPBandwidth moves EIP from the current bandwidth to the configured one.
+// Returns `true` if EIP bandwidth was changed.
+func migrateEIPBandwidth(d *schema.ResourceData, client *golangsdk.ServiceClient, config *cfg.Config) (bool, error) {
+	clientV2, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return false, fmt.Errorf(errCreationV2Client, err)
+	}
+
+	eip, err := eips.Get(client, d.Id()).Extract()
+	if err != nil {
+		return false, fmt.Errorf("error fetching EIP: %w", err)
+	}
+
+	oldType, newType := d.GetChange("bandwidth.0.share_type")
+	newID := d.Get("bandwidth.0.id").(string)
+	if newType.(string) == "WHOLE" && newID == "" {
+		return false, fmt.Errorf("bandwidth ID is required for the shared bandwidth")
+	}
+
+	migrated := false
+	if oldType.(string) == "WHOLE" && (newType.(string) != "WHOLE" || newID != eip.BandwidthID) {
+		// EIP gets dedicated bandwidth with given parameters after removal from the shared one
+		size := d.Get("bandwidth.0.size").(int)
+		if size == 0 {
+			size = 1
+		}
+		chargeMode := d.Get("bandwidth.0.charge_mode").(string)
+		if chargeMode == "" {
+			chargeMode = "bandwidth"
+		}
+		opts := bandwidthsv2.RemoveOpts{
+			ChargeMode:   chargeMode,
+			Size:         size,
+			PublicIpInfo: []bandwidthsv2.PublicIpInfoID{{PublicIpID: d.Id()}},
+		}
+		log.Printf("[DEBUG] Removing EIP %s from the bandwidth %s", d.Id(), eip.BandwidthID)
+		if err := bandwidthsv2.Remove(clientV2, eip.BandwidthID, opts).ExtractErr(); err != nil {
+			return false, fmt.Errorf("error removing EIP from the bandwidth %s: %w", eip.BandwidthID, err)
+		}
+		migrated = true
+	}
+
+	if newType.(string) == "WHOLE" && newID != eip.BandwidthID {
+		opts := bandwidthsv2.InsertOpts{
+			PublicIpInfo: []bandwidthsv2.PublicIpInfoInsertOpts{{PublicIpID: d.Id()}},
+		}
+		log.Printf("[DEBUG] Adding EIP %s to the bandwidth %s", d.Id(), newID)
+		if _, err := bandwidthsv2.Insert(clientV2, newID, opts).Extract(); err != nil {
+			return false, fmt.Errorf("error adding EIP to the bandwidth %s: %w", newID, err)
+		}
+		migrated = true
+	}
+
+	return migrated, nil
+}
+
 func bindToPort(ctx context.Context, d *schema.ResourceData, eipID string, client *golangsdk.ServiceClient, timeout time.Duration) error {
 	publicIPRaw := d.Get("publicip").([]interface{})[0].(map[string]interface{})
 	portID, ok := publicIPRaw["port_id"]
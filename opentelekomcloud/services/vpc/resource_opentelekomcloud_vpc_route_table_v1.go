@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"regexp"
 	"time"
 
@@ -180,7 +181,7 @@ func resourceVpcRouteTableRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("vpc_id", routeTable.VpcID),
 		d.Set("name", routeTable.Name),
 		d.Set("description", routeTable.Description),
-		d.Set("route", expandRouteTableRoutes(routeTable.Routes)),
+		d.Set("route", keepConfiguredRouteDestinations(d, expandRouteTableRoutes(routeTable.Routes))),
 		d.Set("subnets", expandRouteTableSubnets(routeTable.Subnets)),
 		d.Set("created_at", routeTable.CreatedAt),
 		d.Set("updated_at", routeTable.UpdatedAt),
@@ -371,6 +372,34 @@ func expandRouteTableRoutes(routes []routetables.Route) []map[string]interface{}
 	return r
 }
 
+// routeDestinationKey returns the canonical form of the destination CIDR,
+// e.g. `2001:DB8:0:0::/64` and `2001:db8::/64` have the same key
+func routeDestinationKey(destination string) string {
+	ip, ipNet, err := net.ParseCIDR(destination)
+	if err != nil {
+		return destination
+	}
+	ones, _ := ipNet.Mask.Size()
+	return fmt.Sprintf("%s/%d", ip, ones)
+}
+
+// keepConfiguredRouteDestinations replaces the destinations returned by the API with the
+// configured ones when they are the same CIDR written differently, as the API
+// returns IPv6 destinations in its own notation
+func keepConfiguredRouteDestinations(d *schema.ResourceData, routes []map[string]interface{}) []map[string]interface{} {
+	configured := make(map[string]string)
+	for _, raw := range d.Get("route").(*schema.Set).List() {
+		destination := raw.(map[string]interface{})["destination"].(string)
+		configured[routeDestinationKey(destination)] = destination
+	}
+	for _, route := range routes {
+		if destination, ok := configured[routeDestinationKey(route["destination"].(string))]; ok {
+			route["destination"] = destination
+		}
+	}
+	return routes
+}
+
 func expandRouteTableSubnets(subnets []routetables.Subnet) []string {
 	result := make([]string, len(subnets))
 	for i, item := range subnets {
@@ -0,0 +1,22 @@
+package vpc
+
+import (
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestRouteDestinationKey(t *testing.T) {
+	cases := map[string]string{
+		"192.168.0.0/16":           "192.168.0.0/16",
+		"2001:db8::/64":            "2001:db8::/64",
+		"2001:DB8::/64":            "2001:db8::/64",
+		"2001:0db8:0000:0000::/64": "2001:db8::/64",
+		"2001:db8:0:0:0:0:0:1/128": "2001:db8::1/128",
+		"not a cidr":               "not a cidr",
+	}
+
+	for destination, expected := range cases {
+		th.AssertEquals(t, expected, routeDestinationKey(destination))
+	}
+}
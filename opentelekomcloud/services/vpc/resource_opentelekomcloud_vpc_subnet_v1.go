@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -33,6 +34,11 @@ func ResourceVpcSubnetV1() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		// IPv6 can be enabled in-place, but can't be disabled for existing subnet
+		CustomizeDiff: customdiff.ForceNewIfChange("ipv6_enable", func(_ context.Context, old, new, _ interface{}) bool {
+			return old.(bool) && !new.(bool)
+		}),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -78,7 +84,6 @@ func ResourceVpcSubnetV1() *schema.Resource {
 			"ipv6_enable": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
 			},
 			"primary_dns": {
 				Type:         schema.TypeString,
@@ -125,6 +130,10 @@ func ResourceVpcSubnetV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"subnet_id_ipv6": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"network_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -214,10 +223,18 @@ func resourceVpcSubnetV1Read(ctx context.Context, d *schema.ResourceData, meta i
 		return fmterr.Errorf(errCreationV1Client, err)
 	}
 
-	subnet, err := subnets.Get(client, d.Id()).Extract()
+	getResult := subnets.Get(client, d.Id())
+	subnet, err := getResult.Extract()
 	if err != nil {
 		return common.CheckDeletedDiag(d, err, "vpc subnet")
 	}
+	// IPv6 subnet ID is missing in the SDK subnet structure
+	var subnetV6 struct {
+		SubnetIDV6 string `json:"neutron_subnet_id_v6"`
+	}
+	if err := getResult.ExtractIntoStructPtr(&subnetV6, "subnet"); err != nil {
+		return fmterr.Errorf("error extracting IPv6 subnet ID: %w", err)
+	}
 
 	mErr := multierror.Append(
 		d.Set("name", subnet.Name),
@@ -229,6 +246,7 @@ func resourceVpcSubnetV1Read(ctx context.Context, d *schema.ResourceData, meta i
 		d.Set("ipv6_enable", subnet.EnableIpv6),
 		d.Set("cidr_ipv6", subnet.CidrV6),
 		d.Set("gateway_ipv6", subnet.GatewayIpV6),
+		d.Set("subnet_id_ipv6", subnetV6.SubnetIDV6),
 		d.Set("primary_dns", subnet.PrimaryDNS),
 		d.Set("secondary_dns", subnet.SecondaryDNS),
 		d.Set("availability_zone", subnet.AvailabilityZone),
@@ -288,6 +306,10 @@ func resourceVpcSubnetV1Update(ctx context.Context, d *schema.ResourceData, meta
 		enableDHCP := d.Get("dhcp_enable").(bool)
 		updateOpts.EnableDHCP = &enableDHCP
 	}
+	if d.HasChange("ipv6_enable") {
+		enableIpv6 := d.Get("ipv6_enable").(bool)
+		updateOpts.EnableIpv6 = &enableIpv6
+	}
 	if d.HasChange("ntp_addresses") {
 		var extraDhcpRequests []subnets.ExtraDHCPOpt
 		extraDhcpReq := subnets.ExtraDHCPOpt{
@@ -58,9 +58,20 @@ func ResourceVirtualPrivateCloudV1() *schema.Resource {
 				ValidateFunc: validation.IsCIDR,
 			},
 			"secondary_cidr": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.IsCIDR,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.IsCIDR,
+				ConflictsWith: []string{"secondary_cidrs"},
+				Deprecated:    "Use `secondary_cidrs` instead",
+			},
+			"secondary_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+				ConflictsWith: []string{"secondary_cidr"},
 			},
 			"shared": {
 				Type:       schema.TypeBool,
@@ -77,48 +88,62 @@ func ResourceVirtualPrivateCloudV1() *schema.Resource {
 	}
 }
 
-func addSecondaryCidr(d *schema.ResourceData, config *cfg.Config) error {
+func addSecondaryCidrs(d *schema.ResourceData, config *cfg.Config, cidrs []string) error {
 	vpcV3Client, err := config.NetworkingV3Client(config.GetRegion(d))
 	if err != nil {
 		return fmt.Errorf(errCreationV3Client, err)
 	}
-	cidr := d.Get("secondary_cidr").(string)
 	cidrOpts := VpcV3.CidrOpts{
 		Vpc: &VpcV3.AddExtendCidrOption{
-			ExtendCidrs: []string{cidr}},
+			ExtendCidrs: cidrs},
 	}
 	_, err = VpcV3.AddSecondaryCidr(vpcV3Client, d.Id(), cidrOpts)
 	if err != nil {
-		return fmt.Errorf("error setting secondary cidr of VirtualPrivateCloud %s: %s", d.Id(), cidr)
+		return fmt.Errorf("error setting secondary cidrs %v of VirtualPrivateCloud %s: %s", cidrs, d.Id(), err)
 	}
 	return nil
 }
 
-func updateSecondaryCidr(d *schema.ResourceData, config *cfg.Config) error {
+func removeSecondaryCidrs(d *schema.ResourceData, config *cfg.Config, cidrs []string) error {
 	vpcV3Client, err := config.NetworkingV3Client(config.GetRegion(d))
 	if err != nil {
 		return fmt.Errorf(errCreationV3Client, err)
 	}
-	vpcV3Get, err := VpcV3.Get(vpcV3Client, d.Id())
-	if err != nil {
-		return fmt.Errorf("error fetching vpc: %s", err)
-	}
 	cidrOpts := VpcV3.CidrOpts{
 		Vpc: &VpcV3.AddExtendCidrOption{
-			ExtendCidrs: []string{vpcV3Get.SecondaryCidrs[0]}},
+			ExtendCidrs: cidrs},
 	}
 	_, err = VpcV3.RemoveSecondaryCidr(vpcV3Client, d.Id(), cidrOpts)
 	if err != nil {
-		return fmt.Errorf("error removing old secondary cidr of VirtualPrivateCloud %s: %s", d.Id(), vpcV3Get.SecondaryCidrs[0])
+		return fmt.Errorf("error removing secondary cidrs %v of VirtualPrivateCloud %s: %s", cidrs, d.Id(), err)
 	}
-	cidr := d.Get("secondary_cidr").(string)
-	cidrAddOpts := VpcV3.CidrOpts{
-		Vpc: &VpcV3.AddExtendCidrOption{
-			ExtendCidrs: []string{cidr}},
+	return nil
+}
+
+// updateSecondaryCidrs updates secondary CIDRs set by both deprecated `secondary_cidr` and `secondary_cidrs`,
+// so moving a CIDR from one attribute to another doesn't detach it from the VPC
+func updateSecondaryCidrs(d *schema.ResourceData, config *cfg.Config) error {
+	oldCidr, newCidr := d.GetChange("secondary_cidr")
+	oldRaw, newRaw := d.GetChange("secondary_cidrs")
+	oldSet := schema.NewSet(schema.HashString, oldRaw.(*schema.Set).List())
+	newSet := schema.NewSet(schema.HashString, newRaw.(*schema.Set).List())
+	if oldCidr.(string) != "" {
+		oldSet.Add(oldCidr.(string))
 	}
-	_, err = VpcV3.AddSecondaryCidr(vpcV3Client, d.Id(), cidrAddOpts)
-	if err != nil {
-		return fmt.Errorf("error setting new secondary cidr of VirtualPrivateCloud %s: %s", d.Id(), cidr)
+	if newCidr.(string) != "" {
+		newSet.Add(newCidr.(string))
+	}
+
+	// CIDRs are removed first to free the secondary blocks quota
+	if remove := common.ExpandToStringListBySet(oldSet.Difference(newSet)); len(remove) > 0 {
+		if err := removeSecondaryCidrs(d, config, remove); err != nil {
+			return err
+		}
+	}
+	if add := common.ExpandToStringListBySet(newSet.Difference(oldSet)); len(add) > 0 {
+		if err := addSecondaryCidrs(d, config, add); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -133,12 +158,21 @@ func readSecondaryCidr(d *schema.ResourceData, config *cfg.Config) error {
 		return fmt.Errorf("error fetching vpc: %s", err)
 	}
 
-	if len(vpcV3Get.SecondaryCidrs) > 0 {
-		if err := d.Set("secondary_cidr", vpcV3Get.SecondaryCidrs[0]); err != nil {
+	// deprecated `secondary_cidr` is kept only when it's used in the configuration
+	if _, ok := d.GetOk("secondary_cidr"); ok {
+		secondaryCidr := ""
+		if len(vpcV3Get.SecondaryCidrs) > 0 {
+			secondaryCidr = vpcV3Get.SecondaryCidrs[0]
+		}
+		if err := d.Set("secondary_cidr", secondaryCidr); err != nil {
 			return fmt.Errorf("error setting secondary cidr: %s", err)
 		}
+		return nil
 	}
 
+	if err := d.Set("secondary_cidrs", vpcV3Get.SecondaryCidrs); err != nil {
+		return fmt.Errorf("error setting secondary cidrs: %s", err)
+	}
 	return nil
 }
 
@@ -229,8 +263,13 @@ func resourceVirtualPrivateCloudV1Create(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	if _, ok := d.GetOk("secondary_cidr"); ok {
-		if err := addSecondaryCidr(d, config); err != nil {
+	if v, ok := d.GetOk("secondary_cidr"); ok {
+		if err := addSecondaryCidrs(d, config, []string{v.(string)}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if v, ok := d.GetOk("secondary_cidrs"); ok {
+		if err := addSecondaryCidrs(d, config, common.ExpandToStringListBySet(v.(*schema.Set))); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -322,8 +361,8 @@ func resourceVirtualPrivateCloudV1Update(ctx context.Context, d *schema.Resource
 	}
 
 	// update secondary cidr
-	if d.HasChange("secondary_cidr") {
-		if err := updateSecondaryCidr(d, config); err != nil {
+	if d.HasChanges("secondary_cidr", "secondary_cidrs") {
+		if err := updateSecondaryCidrs(d, config); err != nil {
 			return diag.FromErr(err)
 		}
 	}
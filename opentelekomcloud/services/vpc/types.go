@@ -1,6 +1,8 @@
 package vpc
 
 import (
+	"strconv"
+
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/eips"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/routers"
@@ -85,3 +87,22 @@ type EIPCreateOpts struct {
 	eips.ApplyOpts
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
 }
+
+// ToPublicIpApplyMap casts an EIPCreateOpts struct to a map.
+func (opts EIPCreateOpts) ToPublicIpApplyMap() (map[string]interface{}, error) {
+	b, err := opts.ApplyOpts.ToPublicIpApplyMap()
+	if err != nil {
+		return nil, err
+	}
+
+	// API expects IP version as integer value
+	if opts.IP.Version != "" {
+		version, err := strconv.Atoi(opts.IP.Version)
+		if err != nil {
+			return nil, err
+		}
+		b["publicip"].(map[string]interface{})["ip_version"] = version
+	}
+
+	return b, nil
+}
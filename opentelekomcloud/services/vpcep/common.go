@@ -1,13 +1,56 @@
 package vpcep
 
+import (
+	"fmt"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/vpcep/v1/endpoints"
+)
+
 const (
 	ErrClientCreate        = "error creating VPC Endpoint v1 client: %w"
 	keyClient              = "vpcep-client"
 	actionReceive   string = "receive"
 	actionReject    string = "reject"
+
+	permissionDomainPrefix = "iam:domain::"
 )
 
 var approvalActionStatusMap = map[string]string{
 	actionReceive: "accepted",
 	actionReject:  "rejected",
 }
+
+type permissionListOpts struct {
+	Permission string `q:"permission"`
+	Limit      int    `q:"limit"`
+}
+
+// getServicePermission returns the whitelist record of the domain, the search
+// by permission is fuzzy, so the exact match is selected from the results
+func getServicePermission(client *golangsdk.ServiceClient, serviceID, domainID string) (*endpoints.Permission, error) {
+	permission := permissionDomainPrefix + domainID
+	url, err := golangsdk.NewURLBuilder().
+		WithEndpoints("vpc-endpoint-services", serviceID, "permissions").
+		WithQueryParams(&permissionListOpts{Permission: permission, Limit: 500}).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL(url.String()), &r.Body, nil)
+	var res endpoints.GetWhitelistResponse
+	if err := r.ExtractInto(&res); err != nil {
+		return nil, err
+	}
+	for _, p := range res.Permissions {
+		if p.Permission == permission {
+			return &p, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{
+		ErrUnexpectedResponseCode: golangsdk.ErrUnexpectedResponseCode{
+			Body: []byte(fmt.Sprintf("permission %s of VPC EP service %s not found", domainID, serviceID)),
+		},
+	}
+}
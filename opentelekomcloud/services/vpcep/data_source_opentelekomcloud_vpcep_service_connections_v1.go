@@ -0,0 +1,127 @@
+package vpcep
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/vpcep/v1/services"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceVPCEPServiceConnectionsV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVPCEPServiceConnectionsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"pendingAcceptance", "creating", "accepted", "rejected", "failed", "deleting",
+				}, false),
+			},
+			"endpoint_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"packet_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"domain_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVPCEPServiceConnectionsV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ErrClientCreate, err)
+	}
+
+	serviceID := d.Get("service_id").(string)
+	allConnections, err := services.ListConnections(client, serviceID, services.ListConnectionsOpts{
+		ID:     d.Get("endpoint_id").(string),
+		Status: services.Status(d.Get("status").(string)),
+	})
+	if err != nil {
+		return fmterr.Errorf("error listing VPC EP service %s connections: %w", serviceID, err)
+	}
+
+	filterData, err := common.FilterSliceWithField(allConnections, map[string]interface{}{
+		"DomainId": d.Get("domain_id").(string),
+	})
+	if err != nil {
+		return diag.Errorf("error filtering VPC EP service connections: %s", err)
+	}
+
+	connections := make([]map[string]interface{}, 0, len(filterData))
+	ids := make([]string, 0, len(filterData))
+	for _, item := range filterData {
+		v := item.(services.Connection)
+		connections = append(connections, map[string]interface{}{
+			"endpoint_id": v.ID,
+			"packet_id":   v.MarkerId,
+			"domain_id":   v.DomainId,
+			"status":      v.Status,
+			"created_at":  v.CreatedAt,
+			"updated_at":  v.UpdatedAt,
+		})
+		ids = append(ids, v.ID)
+	}
+
+	d.SetId(hashcode.Strings(append([]string{serviceID}, ids...)))
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("connections", connections),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
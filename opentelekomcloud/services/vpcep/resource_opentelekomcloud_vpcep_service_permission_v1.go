@@ -0,0 +1,123 @@
+package vpcep
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/vpcep/v1/endpoints"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceVPCEPServicePermissionV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVPCEPServicePermissionCreate,
+		ReadContext:   resourceVPCEPServicePermissionRead,
+		DeleteContext: resourceVPCEPServicePermissionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: common.ImportByPath("service_id", "domain_id"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"permission_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func updateServicePermission(client *golangsdk.ServiceClient, serviceID, domainID, action string) error {
+	_, err := endpoints.BatchUpdateWhitelist(client, serviceID, endpoints.BatchUpdateReq{
+		Permissions: []string{permissionDomainPrefix + domainID},
+		Action:      action,
+	})
+	return err
+}
+
+func resourceVPCEPServicePermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClient, func() (*golangsdk.ServiceClient, error) {
+		return config.VpcEpV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(ErrClientCreate, err)
+	}
+
+	serviceID := d.Get("service_id").(string)
+	domainID := d.Get("domain_id").(string)
+	if err := updateServicePermission(client, serviceID, domainID, "add"); err != nil {
+		return fmterr.Errorf("error adding domain %s to VPC EP service %s whitelist: %w", domainID, serviceID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceID, domainID))
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClient)
+	return resourceVPCEPServicePermissionRead(clientCtx, d, meta)
+}
+
+func resourceVPCEPServicePermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClient, func() (*golangsdk.ServiceClient, error) {
+		return config.VpcEpV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(ErrClientCreate, err)
+	}
+
+	permission, err := getServicePermission(client, d.Get("service_id").(string), d.Get("domain_id").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "VPC EP service permission")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("permission_id", permission.Id),
+		d.Set("created_at", permission.CreatedAt),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceVPCEPServicePermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClient, func() (*golangsdk.ServiceClient, error) {
+		return config.VpcEpV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(ErrClientCreate, err)
+	}
+
+	serviceID := d.Get("service_id").(string)
+	domainID := d.Get("domain_id").(string)
+	if err := updateServicePermission(client, serviceID, domainID, "remove"); err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return nil
+		}
+		return fmterr.Errorf("error removing domain %s from VPC EP service %s whitelist: %w", domainID, serviceID, err)
+	}
+	return nil
+}
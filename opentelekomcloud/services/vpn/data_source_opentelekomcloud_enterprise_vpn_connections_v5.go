@@ -0,0 +1,224 @@
+package vpn
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/evpn/v5/connection"
+	connection_monitoring "github.com/opentelekomcloud/gophertelekomcloud/openstack/evpn/v5/connection-monitoring"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceEnterpriseConnectionsV5() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEvpnConnectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"connection_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"gateway_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"gateway_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"gateway_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"customer_gateway_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpn_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ha_role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"monitor_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"monitor_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"monitor_source_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"monitor_destination_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ike_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ike_encryption_algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ike_authentication_algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ike_dh_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipsec_encryption_algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipsec_authentication_algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipsec_pfs": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEvpnConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV5, func() (*golangsdk.ServiceClient, error) {
+		return config.EvpnV5Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV5Client, err)
+	}
+
+	var connections []connection.Connection
+	if id := d.Get("connection_id").(string); id != "" {
+		conn, err := connection.Get(client, id)
+		if err != nil {
+			return diag.Errorf("error retrieving OpenTelekomCloud EVPN connection (%s): %s", id, err)
+		}
+		connections = append(connections, *conn)
+	} else {
+		connections, err = connection.List(client, connection.ListOpts{
+			VgwId: d.Get("gateway_id").(string),
+			VgwIp: d.Get("gateway_ip").(string),
+		})
+		if err != nil {
+			return diag.Errorf("error retrieving OpenTelekomCloud EVPN connections: %s", err)
+		}
+	}
+
+	filter := map[string]interface{}{
+		"VgwId":  d.Get("gateway_id"),
+		"VgwIp":  d.Get("gateway_ip"),
+		"Status": d.Get("status"),
+	}
+	filtered, err := common.FilterSliceWithField(connections, filter)
+	if err != nil {
+		return diag.Errorf("error filtering OpenTelekomCloud EVPN connections: %s", err)
+	}
+
+	monitors, err := connection_monitoring.List(client, connection_monitoring.ListOpts{})
+	if err != nil {
+		return diag.Errorf("error retrieving OpenTelekomCloud EVPN connection monitors: %s", err)
+	}
+	monitorByConnection := make(map[string]connection_monitoring.Monitor, len(monitors))
+	for _, m := range monitors {
+		monitorByConnection[m.ConnectionId] = m
+	}
+
+	var ids []string
+	result := make([]map[string]interface{}, 0, len(filtered))
+	for _, item := range filtered {
+		conn := item.(connection.Connection)
+		ids = append(ids, conn.ID)
+
+		monitor := monitorByConnection[conn.ID]
+		result = append(result, map[string]interface{}{
+			"id":                             conn.ID,
+			"name":                           conn.Name,
+			"gateway_id":                     conn.VgwId,
+			"gateway_ip":                     conn.VgwIp,
+			"customer_gateway_id":            conn.CgwId,
+			"vpn_type":                       conn.Style,
+			"ha_role":                        conn.HaRole,
+			"status":                         conn.Status,
+			"created_at":                     conn.CreatedAt,
+			"updated_at":                     conn.UpdatedAt,
+			"monitor_id":                     monitor.ID,
+			"monitor_status":                 monitor.Status,
+			"monitor_source_ip":              monitor.SourceIp,
+			"monitor_destination_ip":         monitor.DestinationIp,
+			"ike_version":                    conn.IkePolicy.IkeVersion,
+			"ike_encryption_algorithm":       conn.IkePolicy.EncryptionAlgorithm,
+			"ike_authentication_algorithm":   conn.IkePolicy.AuthenticationAlgorithm,
+			"ike_dh_group":                   conn.IkePolicy.DhGroup,
+			"ipsec_encryption_algorithm":     conn.IpSecPolicy.EncryptionAlgorithm,
+			"ipsec_authentication_algorithm": conn.IpSecPolicy.AuthenticationAlgorithm,
+			"ipsec_pfs":                      conn.IpSecPolicy.Pfs,
+		})
+	}
+
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("connections", result),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
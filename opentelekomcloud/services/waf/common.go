@@ -2,6 +2,7 @@ package waf
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 )
 
@@ -27,6 +28,8 @@ const (
 	ProtectionActionAllow = 1
 	// ProtectionActionLog log the request only
 	ProtectionActionLog = 2
+	// wafEventsPageSize the maximum page size of the attack events list API
+	wafEventsPageSize = 100
 )
 
 func wafRuleImporter() *schema.ResourceImporter {
@@ -40,3 +43,66 @@ type ExtendOptions struct {
 	CheckAllHeaders       *bool `json:"check_all_headers,omitempty"`
 	ShiroRememberMeEnable *bool `json:"shiro_rememberMe_enable,omitempty"`
 }
+
+// wafEventListOpts is the query of the attack events list API, which is missing in the SDK
+type wafEventListOpts struct {
+	// Recent is the predefined time range: yesterday, today, 3days, 1week or 1month
+	Recent string `q:"recent,omitempty"`
+	// From is the start time in milliseconds, used together with To
+	From int64 `q:"from,omitempty"`
+	// To is the end time in milliseconds
+	To int64 `q:"to,omitempty"`
+	// Attacks are the attack types, e.g. sqli, xss, cc
+	Attacks []string `q:"attacks"`
+	// Hosts are the IDs of the protected domains
+	Hosts    []string `q:"hosts"`
+	Page     int      `q:"page,omitempty"`
+	PageSize int      `q:"pagesize,omitempty"`
+}
+
+type wafEvent struct {
+	ID       string `json:"id"`
+	Time     int64  `json:"time"`
+	PolicyID string `json:"policyid"`
+	SourceIP string `json:"sip"`
+	Host     string `json:"host"`
+	HostID   string `json:"host_id"`
+	URL      string `json:"url"`
+	Attack   string `json:"attack"`
+	Rule     string `json:"rule"`
+	Payload  string `json:"payload"`
+	Action   string `json:"action"`
+	Status   string `json:"status"`
+	Region   string `json:"region"`
+}
+
+// listWafEvents returns all the attack events matching the query
+func listWafEvents(client *golangsdk.ServiceClient, opts wafEventListOpts) ([]wafEvent, error) {
+	opts.PageSize = wafEventsPageSize
+	var events []wafEvent
+	for opts.Page = 1; ; opts.Page++ {
+		// GET /v1/{project_id}/waf/event
+		url, err := golangsdk.NewURLBuilder().
+			WithEndpoints("waf", "event").
+			WithQueryParams(&opts).Build()
+		if err != nil {
+			return nil, err
+		}
+
+		var r golangsdk.Result
+		_, r.Err = client.Get(client.ServiceURL(url.String()), &r.Body, nil)
+		if r.Err != nil {
+			return nil, r.Err
+		}
+
+		var page []wafEvent
+		if err := r.ExtractIntoSlicePtr(&page, "items"); err != nil {
+			return nil, err
+		}
+		events = append(events, page...)
+
+		if len(page) < wafEventsPageSize {
+			return events, nil
+		}
+	}
+}
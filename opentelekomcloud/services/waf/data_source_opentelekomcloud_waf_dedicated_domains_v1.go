@@ -0,0 +1,192 @@
+package waf
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domains "github.com/opentelekomcloud/gophertelekomcloud/openstack/waf-premium/v1/hosts"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceWafDedicatedDomainsV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWafDedicatedDomainsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domains": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"proxy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"protect_status": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"access_status": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"certificate_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tls": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cipher": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_protocol": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"server_protocol": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"vpc_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWafDedicatedDomainsV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	var allDomains []domains.Host
+	for page := 1; ; page++ {
+		pageDomains, err := domains.List(client, domains.ListOpts{
+			PageSize: strconv.Itoa(wafRulesPageSize),
+			Page:     strconv.Itoa(page),
+			Hostname: d.Get("domain").(string),
+		})
+		if err != nil {
+			return fmterr.Errorf("error listing OpenTelekomCloud WAF Dedicated domains: %w", err)
+		}
+		allDomains = append(allDomains, pageDomains...)
+		if len(pageDomains) < wafRulesPageSize {
+			break
+		}
+	}
+
+	filterData, err := common.FilterSliceWithField(allDomains, map[string]interface{}{
+		"PolicyId": d.Get("policy_id").(string),
+	})
+	if err != nil {
+		return diag.Errorf("error filtering OpenTelekomCloud WAF Dedicated domains: %s", err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(filterData))
+	ids := make([]string, 0, len(filterData))
+	for _, item := range filterData {
+		v := item.(domains.Host)
+		result = append(result, map[string]interface{}{
+			"id":               v.ID,
+			"domain":           v.Hostname,
+			"policy_id":        v.PolicyId,
+			"protocol":         v.Protocol,
+			"proxy":            v.Proxy,
+			"protect_status":   v.ProtectStatus,
+			"access_status":    v.AccessStatus,
+			"certificate_id":   v.CertificateId,
+			"certificate_name": v.CertificateName,
+			"tls":              v.Tls,
+			"cipher":           v.Cipher,
+			"server":           buildDomainServerAttributes(&v),
+			"description":      v.Description,
+			"created_at":       v.CreatedAt,
+		})
+		ids = append(ids, v.ID)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("domains", result),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
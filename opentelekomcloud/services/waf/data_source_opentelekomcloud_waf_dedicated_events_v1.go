@@ -0,0 +1,215 @@
+package waf
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceWafDedicatedEventsV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWafDedicatedEventsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"recent": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"yesterday", "today", "3days", "1week", "1month",
+				}, false),
+				ConflictsWith: []string{"from", "to"},
+			},
+			"from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				RequiredWith: []string{"to"},
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				RequiredWith: []string{"from"},
+			},
+			"domain_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"attacks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rule": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attack": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"payload": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"total": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"action_counts": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"attack_counts": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceWafDedicatedEventsV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	opts := wafEventListOpts{
+		Recent:  d.Get("recent").(string),
+		Attacks: common.ExpandToStringList(d.Get("attacks").([]interface{})),
+		Hosts:   common.ExpandToStringList(d.Get("domain_ids").([]interface{})),
+	}
+	if v, ok := d.GetOk("from"); ok {
+		from, _ := time.Parse(time.RFC3339, v.(string))
+		to, _ := time.Parse(time.RFC3339, d.Get("to").(string))
+		opts.From = from.UnixMilli()
+		opts.To = to.UnixMilli()
+	} else if opts.Recent == "" {
+		opts.Recent = "today"
+	}
+
+	allEvents, err := listWafEvents(client, opts)
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud WAF Dedicated attack events: %w", err)
+	}
+
+	// the API doesn't support filtering by rule and action
+	filterData, err := common.FilterSliceWithField(allEvents, map[string]interface{}{
+		"Rule":   d.Get("rule").(string),
+		"Action": d.Get("action").(string),
+	})
+	if err != nil {
+		return diag.Errorf("error filtering OpenTelekomCloud WAF Dedicated attack events: %s", err)
+	}
+
+	events := make([]map[string]interface{}, 0, len(filterData))
+	ids := make([]string, 0, len(filterData))
+	actionCounts := make(map[string]int)
+	attackCounts := make(map[string]int)
+	for _, item := range filterData {
+		v := item.(wafEvent)
+		events = append(events, map[string]interface{}{
+			"id":        v.ID,
+			"time":      time.UnixMilli(v.Time).UTC().Format(time.RFC3339),
+			"policy_id": v.PolicyID,
+			"domain":    v.Host,
+			"domain_id": v.HostID,
+			"source_ip": v.SourceIP,
+			"url":       v.URL,
+			"attack":    v.Attack,
+			"rule":      v.Rule,
+			"action":    v.Action,
+			"payload":   v.Payload,
+			"status":    v.Status,
+			"region":    v.Region,
+		})
+		ids = append(ids, v.ID)
+		actionCounts[v.Action]++
+		attackCounts[v.Attack]++
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("events", events),
+		d.Set("total", len(events)),
+		d.Set("action_counts", actionCounts),
+		d.Set("attack_counts", attackCounts),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
@@ -0,0 +1,169 @@
+package waf
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf-premium/v1/instances"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceWafDedicatedInstancesV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWafDedicatedInstancesV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"architecture": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"flavor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"server_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"access_status": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"upgradable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"specification": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domains": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWafDedicatedInstancesV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	allInstances, err := instances.List(client, instances.ListOpts{})
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud WAF Dedicated instances: %w", err)
+	}
+
+	filterData, err := common.FilterSliceWithField(allInstances, map[string]interface{}{
+		"Name":  d.Get("name").(string),
+		"VpcID": d.Get("vpc_id").(string),
+	})
+	if err != nil {
+		return diag.Errorf("error filtering OpenTelekomCloud WAF Dedicated instances: %s", err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(filterData))
+	ids := make([]string, 0, len(filterData))
+	for _, item := range filterData {
+		v := item.(instances.Instance)
+		domains := make([]string, 0, len(v.Hosts))
+		for _, host := range v.Hosts {
+			domains = append(domains, host.Hostname)
+		}
+		result = append(result, map[string]interface{}{
+			"id":                v.ID,
+			"name":              v.Name,
+			"availability_zone": v.AvailabilityZone,
+			"architecture":      v.Architecture,
+			"flavor":            v.Flavor,
+			"vpc_id":            v.VpcID,
+			"subnet_id":         v.SubnetId,
+			"security_group":    v.SecurityGroups,
+			"server_id":         v.ServerId,
+			"service_ip":        v.ServiceIp,
+			"status":            v.Status,
+			"access_status":     v.AccessStatus,
+			"upgradable":        v.Upgradable == 1,
+			"specification":     v.ResourceSpecification,
+			"domains":           domains,
+			"created_at":        v.CreatedAt,
+		})
+		ids = append(ids, v.ID)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("instances", result),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
@@ -0,0 +1,211 @@
+package waf
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf-premium/v1/policies"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceWafDedicatedPoliciesV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWafDedicatedPoliciesV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protection_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"level": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"full_detection": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"options": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"web_attack": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"common": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"crawler": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"anti_crawler": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"crawler_engine": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"crawler_scanner": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"crawler_script": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"crawler_other": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"web_shell": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"cc": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"custom": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"blacklist": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"geolocation_access_control": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"ignore": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"privacy": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"anti_tamper": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"anti_leakage": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"followed_action": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"bot_enable": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"precise": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"domains": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWafDedicatedPoliciesV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	// all policies are returned when the page size is not set
+	allPolicies, err := policies.List(client, policies.ListOpts{
+		Name: d.Get("name").(string),
+	})
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud WAF Dedicated policies: %w", err)
+	}
+
+	// the API supports only fuzzy search by name
+	filterData, err := common.FilterSliceWithField(allPolicies, map[string]interface{}{
+		"Name": d.Get("name").(string),
+	})
+	if err != nil {
+		return diag.Errorf("error filtering OpenTelekomCloud WAF Dedicated policies: %s", err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(filterData))
+	ids := make([]string, 0, len(filterData))
+	for _, item := range filterData {
+		v := item.(policies.Policy)
+		protectionMode := ""
+		if v.Action != nil {
+			protectionMode = v.Action.Category
+		}
+		result = append(result, map[string]interface{}{
+			"id":              v.ID,
+			"name":            v.Name,
+			"protection_mode": protectionMode,
+			"level":           v.Level,
+			"full_detection":  v.FullDetection,
+			"options":         flattenWafDedicatedPolicyOptions(v.Options),
+			"domains":         v.Hosts,
+			"created_at":      v.CreatedAt,
+		})
+		ids = append(ids, v.ID)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("policies", result),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
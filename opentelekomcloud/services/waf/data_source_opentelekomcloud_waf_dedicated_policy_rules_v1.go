@@ -0,0 +1,120 @@
+package waf
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf-premium/v1/policies"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceWafDedicatedPolicyRulesV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWafDedicatedPolicyRulesV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(wafRuleKindNames(), false),
+			},
+			"rules": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"rule": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWafDedicatedPolicyRulesV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	policyID := d.Get("policy_id").(string)
+	if _, err := policies.Get(client, policyID); err != nil {
+		return fmterr.Errorf("error retrieving OpenTelekomCloud WAF Dedicated policy: %w", err)
+	}
+
+	kindNames := wafRuleKindNames()
+	if ruleType := d.Get("type").(string); ruleType != "" {
+		kindNames = []string{ruleType}
+	}
+
+	ruleSet := make(map[string][]wafRule)
+	var items []map[string]interface{}
+	for _, kindName := range kindNames {
+		kindRules, err := listWafPolicyRules(client, policyID, wafRuleKinds[kindName])
+		if err != nil {
+			return fmterr.Errorf("error listing OpenTelekomCloud WAF Dedicated %s rules: %w", kindName, err)
+		}
+		ruleSet[kindName] = kindRules
+		for _, rule := range kindRules {
+			items = append(items, map[string]interface{}{
+				"id":      rule.ID,
+				"type":    kindName,
+				"enabled": rule.Enabled,
+				"rule":    rule.Body,
+			})
+		}
+	}
+
+	document, err := renderWafRulesDocument(ruleSet)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(policyID)
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("rules", document),
+		d.Set("rule_count", len(items)),
+		d.Set("items", items),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
@@ -0,0 +1,660 @@
+package waf
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf-premium/v1/policies"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf-premium/v1/rules"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+const (
+	wafRulesPageSize = 100
+	// wafRuleEnabledKey is the only key of the rule in the document which is not a part of the API request
+	wafRuleEnabledKey = "enabled"
+)
+
+// wafRuleKind describes one type of the dedicated WAF policy rules
+type wafRuleKind struct {
+	// path is the type of the rule in the API URLs
+	path string
+	// hasStatus is false if the rule can't be enabled or disabled
+	hasStatus bool
+	// opts returns the pointer to the empty create options of the rule
+	opts   func() interface{}
+	create func(client *golangsdk.ServiceClient, policyID string, opts interface{}) (string, error)
+	list   func(client *golangsdk.ServiceClient, policyID string, page int) (interface{}, error)
+	delete func(client *golangsdk.ServiceClient, policyID, ruleID string) error
+}
+
+// wafRuleKinds are the rule types supported in the rules document, the keys match the rule resource names
+var wafRuleKinds = map[string]wafRuleKind{
+	"alarm_masking": {
+		path:      "ignore",
+		hasStatus: true,
+		opts:      func() interface{} { return &rules.CreateIgnoreOpts{} },
+		create: func(client *golangsdk.ServiceClient, policyID string, opts interface{}) (string, error) {
+			rule, err := rules.CreateIgnore(client, policyID, *opts.(*rules.CreateIgnoreOpts))
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		list: func(client *golangsdk.ServiceClient, policyID string, page int) (interface{}, error) {
+			return rules.ListIgnore(client, policyID, rules.ListIgnoreOpts{PageSize: wafRulesPageSize, Page: page})
+		},
+		delete: rules.DeleteIgnoreRule,
+	},
+	"anti_crawler": {
+		path:      "anticrawler",
+		hasStatus: true,
+		opts:      func() interface{} { return &rules.CreateAntiCrawlerOpts{} },
+		create: func(client *golangsdk.ServiceClient, policyID string, opts interface{}) (string, error) {
+			rule, err := rules.CreateAntiCrawler(client, policyID, *opts.(*rules.CreateAntiCrawlerOpts))
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		list: func(client *golangsdk.ServiceClient, policyID string, page int) (interface{}, error) {
+			return rules.ListAntiCrawlers(client, policyID, rules.ListAntiCrawlerOpts{PageSize: wafRulesPageSize, Page: page})
+		},
+		delete: rules.DeleteAntiCrawlerRule,
+	},
+	"anti_leakage": {
+		path:      "antileakage",
+		hasStatus: true,
+		opts:      func() interface{} { return &rules.CreateAntiLeakageOpts{} },
+		create: func(client *golangsdk.ServiceClient, policyID string, opts interface{}) (string, error) {
+			rule, err := rules.CreateAntiLeakage(client, policyID, *opts.(*rules.CreateAntiLeakageOpts))
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		list: func(client *golangsdk.ServiceClient, policyID string, page int) (interface{}, error) {
+			return rules.ListAntiLeakage(client, policyID, rules.ListAntiLeakageOpts{PageSize: wafRulesPageSize, Page: page})
+		},
+		delete: rules.DeleteAntiLeakageRule,
+	},
+	"blacklist": {
+		path:      "whiteblackip",
+		hasStatus: true,
+		opts:      func() interface{} { return &rules.BlacklistCreateOpts{} },
+		create: func(client *golangsdk.ServiceClient, policyID string, opts interface{}) (string, error) {
+			rule, err := rules.CreateBlacklist(client, policyID, *opts.(*rules.BlacklistCreateOpts))
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		list: func(client *golangsdk.ServiceClient, policyID string, page int) (interface{}, error) {
+			return rules.ListBlacklists(client, policyID, rules.ListBlacklistOpts{PageSize: wafRulesPageSize, Page: page})
+		},
+		delete: rules.DeleteBlacklistRule,
+	},
+	"cc": {
+		path:      "cc",
+		hasStatus: true,
+		opts:      func() interface{} { return &rules.CreateCcOpts{} },
+		create: func(client *golangsdk.ServiceClient, policyID string, opts interface{}) (string, error) {
+			rule, err := rules.CreateCc(client, policyID, *opts.(*rules.CreateCcOpts))
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		list: func(client *golangsdk.ServiceClient, policyID string, page int) (interface{}, error) {
+			return rules.ListCcs(client, policyID, rules.ListCcOpts{PageSize: wafRulesPageSize, Page: page})
+		},
+		delete: rules.DeleteCcRule,
+	},
+	"data_masking": {
+		path:      "privacy",
+		hasStatus: true,
+		opts:      func() interface{} { return &rules.CreatePrivacyOpts{} },
+		create: func(client *golangsdk.ServiceClient, policyID string, opts interface{}) (string, error) {
+			rule, err := rules.CreatePrivacy(client, policyID, *opts.(*rules.CreatePrivacyOpts))
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		list: func(client *golangsdk.ServiceClient, policyID string, page int) (interface{}, error) {
+			return rules.ListPrivacy(client, policyID, rules.ListPrivacyOpts{PageSize: wafRulesPageSize, Page: page})
+		},
+		delete: rules.DeletePrivacyRule,
+	},
+	"geo_ip": {
+		path:      "geoip",
+		hasStatus: true,
+		opts:      func() interface{} { return &rules.CreateGeoIpOpts{} },
+		create: func(client *golangsdk.ServiceClient, policyID string, opts interface{}) (string, error) {
+			rule, err := rules.CreateGeoIp(client, policyID, *opts.(*rules.CreateGeoIpOpts))
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		list: func(client *golangsdk.ServiceClient, policyID string, page int) (interface{}, error) {
+			return rules.ListGeoIp(client, policyID, rules.ListGeoIpOpts{PageSize: wafRulesPageSize, Page: page})
+		},
+		delete: rules.DeleteGeoIpRule,
+	},
+	"known_attack_source": {
+		path:      "punishment",
+		hasStatus: false,
+		opts:      func() interface{} { return &rules.CreateKnownAttackSourceOpts{} },
+		create: func(client *golangsdk.ServiceClient, policyID string, opts interface{}) (string, error) {
+			rule, err := rules.CreateKnownAttackSource(client, policyID, *opts.(*rules.CreateKnownAttackSourceOpts))
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		list: func(client *golangsdk.ServiceClient, policyID string, page int) (interface{}, error) {
+			return rules.ListKnownAttackSource(client, policyID, rules.ListKnownAttackSourceOpts{PageSize: wafRulesPageSize, Page: page})
+		},
+		delete: rules.DeleteKnownAttackSourceRule,
+	},
+	"precise_protection": {
+		path:      "custom",
+		hasStatus: true,
+		opts:      func() interface{} { return &rules.CreateCustomOpts{} },
+		create: func(client *golangsdk.ServiceClient, policyID string, opts interface{}) (string, error) {
+			rule, err := rules.CreateCustom(client, policyID, *opts.(*rules.CreateCustomOpts))
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		list: func(client *golangsdk.ServiceClient, policyID string, page int) (interface{}, error) {
+			return rules.ListCustoms(client, policyID, rules.ListCustomOpts{PageSize: wafRulesPageSize, Page: page})
+		},
+		delete: rules.DeleteCustomRule,
+	},
+	"web_tamper": {
+		path:      "antitamper",
+		hasStatus: true,
+		opts:      func() interface{} { return &rules.CreateAntiTamperOpts{} },
+		create: func(client *golangsdk.ServiceClient, policyID string, opts interface{}) (string, error) {
+			rule, err := rules.CreateAntiTamper(client, policyID, *opts.(*rules.CreateAntiTamperOpts))
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+		list: func(client *golangsdk.ServiceClient, policyID string, page int) (interface{}, error) {
+			return rules.ListAntiTamper(client, policyID, rules.ListAntiTamperOpts{PageSize: wafRulesPageSize, Page: page})
+		},
+		delete: rules.DeleteAntiTamperRule,
+	},
+}
+
+// wafRuleKindNames returns the sorted names of the supported rule types
+func wafRuleKindNames() []string {
+	names := make([]string, 0, len(wafRuleKinds))
+	for name := range wafRuleKinds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// wafRule is the rule from the document or from the API in the canonical form
+type wafRule struct {
+	ID      string
+	Enabled bool
+	// Body is the canonical JSON of the rule create options, it's used to compare the rules
+	Body string
+}
+
+// pruneEmptyValues removes null values, empty strings, lists and objects recursively,
+// so omitted and empty fields of the rule are compared as equal.
+// Zero numbers and false are kept, as e.g. `priority = 0` and `white = 0` are meaningful.
+func pruneEmptyValues(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, item := range value {
+			pruned := pruneEmptyValues(item)
+			if pruned == nil {
+				delete(value, k)
+				continue
+			}
+			value[k] = pruned
+		}
+		if len(value) == 0 {
+			return nil
+		}
+		return value
+	case []interface{}:
+		result := make([]interface{}, 0, len(value))
+		for _, item := range value {
+			if pruned := pruneEmptyValues(item); pruned != nil {
+				result = append(result, pruned)
+			}
+		}
+		if len(result) == 0 {
+			return nil
+		}
+		return result
+	case string:
+		if value == "" {
+			return nil
+		}
+	}
+	return v
+}
+
+// dropZeroOptionalPointers sets the `omitempty` pointer fields pointing to zero values to nil recursively.
+// The API returns defaults for such fields, e.g. `"lock_time": 0` of CC rules,
+// so the rule without the field has the same canonical body as the rule returned by the API.
+func dropZeroOptionalPointers(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			dropZeroOptionalPointers(v.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			dropZeroOptionalPointers(v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Field(i)
+			if !field.CanSet() {
+				continue
+			}
+			tag := v.Type().Field(i).Tag.Get("json")
+			if field.Kind() == reflect.Ptr && !field.IsNil() && field.Elem().IsZero() &&
+				strings.Contains(tag, ",omitempty") {
+				field.Set(reflect.Zero(field.Type()))
+				continue
+			}
+			dropZeroOptionalPointers(field)
+		}
+	}
+}
+
+// canonicalWafRuleBody converts the rule or rule options to the canonical JSON of the create options
+func canonicalWafRuleBody(kind wafRuleKind, rule interface{}) (string, error) {
+	raw, err := json.Marshal(rule)
+	if err != nil {
+		return "", err
+	}
+	opts := kind.opts()
+	if err := json.Unmarshal(raw, opts); err != nil {
+		return "", err
+	}
+	dropZeroOptionalPointers(reflect.ValueOf(opts))
+	raw, err = json.Marshal(opts)
+	if err != nil {
+		return "", err
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return "", err
+	}
+	pruned := pruneEmptyValues(body)
+	if pruned == nil {
+		pruned = map[string]interface{}{}
+	}
+	// map keys are sorted by json.Marshal
+	raw, err = json.Marshal(pruned)
+	return string(raw), err
+}
+
+// parseWafRulesDocument parses the rules document to the canonical rules grouped by the rule kind
+func parseWafRulesDocument(document string) (map[string][]wafRule, error) {
+	var raw map[string][]map[string]interface{}
+	if err := json.Unmarshal([]byte(document), &raw); err != nil {
+		return nil, fmt.Errorf("rules document must be a JSON object with lists of rules: %w", err)
+	}
+
+	result := make(map[string][]wafRule)
+	for kindName, items := range raw {
+		kind, ok := wafRuleKinds[kindName]
+		if !ok {
+			return nil, fmt.Errorf("unsupported rule type %q", kindName)
+		}
+		for i, item := range items {
+			enabled := true
+			if v, ok := item[wafRuleEnabledKey]; ok {
+				b, ok := v.(bool)
+				if !ok {
+					return nil, fmt.Errorf("%s.%d: %q must be a boolean", kindName, i, wafRuleEnabledKey)
+				}
+				enabled = b
+				delete(item, wafRuleEnabledKey)
+			}
+			if !enabled && !kind.hasStatus {
+				return nil, fmt.Errorf("%s.%d: %s rules can't be disabled", kindName, i, kindName)
+			}
+			body, err := canonicalWafRuleBody(kind, item)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%d: %w", kindName, i, err)
+			}
+			result[kindName] = append(result[kindName], wafRule{Enabled: enabled, Body: body})
+		}
+	}
+	return result, nil
+}
+
+// renderWafRulesDocument renders the canonical rules document, rules of each type are sorted by their content
+func renderWafRulesDocument(ruleSet map[string][]wafRule) (string, error) {
+	document := make(map[string][]json.RawMessage)
+	for kindName, kindRules := range ruleSet {
+		if len(kindRules) == 0 {
+			continue
+		}
+		items := make([]string, len(kindRules))
+		for i, rule := range kindRules {
+			item := rule.Body
+			if !rule.Enabled {
+				var body map[string]interface{}
+				if err := json.Unmarshal([]byte(item), &body); err != nil {
+					return "", err
+				}
+				body[wafRuleEnabledKey] = false
+				raw, err := json.Marshal(body)
+				if err != nil {
+					return "", err
+				}
+				item = string(raw)
+			}
+			items[i] = item
+		}
+		sort.Strings(items)
+		for _, item := range items {
+			document[kindName] = append(document[kindName], json.RawMessage(item))
+		}
+	}
+	raw, err := json.Marshal(document)
+	return string(raw), err
+}
+
+func normalizeWafRulesDocument(v interface{}) string {
+	ruleSet, err := parseWafRulesDocument(v.(string))
+	if err != nil {
+		return v.(string)
+	}
+	document, err := renderWafRulesDocument(ruleSet)
+	if err != nil {
+		return v.(string)
+	}
+	return document
+}
+
+func validateWafRulesDocument(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseWafRulesDocument(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid rules document: %w", k, err))
+	}
+	return
+}
+
+// listWafPolicyRules returns all the rules of the given kind in the policy
+func listWafPolicyRules(client *golangsdk.ServiceClient, policyID string, kind wafRuleKind) ([]wafRule, error) {
+	var result []wafRule
+	for page := 1; ; page++ {
+		pageRules, err := kind.list(client, policyID, page)
+		if err != nil {
+			return nil, err
+		}
+		raw, err := json.Marshal(pageRules)
+		if err != nil {
+			return nil, err
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			var meta struct {
+				ID     string `json:"id"`
+				Status *int   `json:"status"`
+			}
+			if err := json.Unmarshal(item, &meta); err != nil {
+				return nil, err
+			}
+			body, err := canonicalWafRuleBody(kind, item)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, wafRule{
+				ID:      meta.ID,
+				Enabled: meta.Status == nil || *meta.Status == 1,
+				Body:    body,
+			})
+		}
+
+		if len(items) < wafRulesPageSize {
+			return result, nil
+		}
+	}
+}
+
+func ResourceWafDedicatedPolicyRulesV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWafDedicatedPolicyRulesV1Create,
+		ReadContext:   resourceWafDedicatedPolicyRulesV1Read,
+		UpdateContext: resourceWafDedicatedPolicyRulesV1Update,
+		DeleteContext: resourceWafDedicatedPolicyRulesV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rules": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateWafRulesDocument,
+				StateFunc:    normalizeWafRulesDocument,
+			},
+			"rule_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceWafDedicatedPolicyRulesV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.WafDedicatedV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	policyID := d.Get("policy_id").(string)
+	if err := reconcileWafPolicyRules(client, policyID, d.Get("rules").(string)); err != nil {
+		return fmterr.Errorf("error applying OpenTelekomCloud WAF Dedicated policy rules: %w", err)
+	}
+	d.SetId(policyID)
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV1)
+	return resourceWafDedicatedPolicyRulesV1Read(clientCtx, d, meta)
+}
+
+func resourceWafDedicatedPolicyRulesV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.WafDedicatedV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	if _, err := policies.Get(client, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "WAF Dedicated policy")
+	}
+
+	ruleSet := make(map[string][]wafRule)
+	count := 0
+	for kindName, kind := range wafRuleKinds {
+		kindRules, err := listWafPolicyRules(client, d.Id(), kind)
+		if err != nil {
+			return fmterr.Errorf("error listing OpenTelekomCloud WAF Dedicated %s rules: %w", kindName, err)
+		}
+		ruleSet[kindName] = kindRules
+		count += len(kindRules)
+	}
+
+	document, err := renderWafRulesDocument(ruleSet)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d rules of WAF Dedicated policy %s", count, d.Id())
+	mErr := multierror.Append(
+		d.Set("policy_id", d.Id()),
+		d.Set("rules", document),
+		d.Set("rule_count", count),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceWafDedicatedPolicyRulesV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.WafDedicatedV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	if d.HasChange("rules") {
+		if err := reconcileWafPolicyRules(client, d.Id(), d.Get("rules").(string)); err != nil {
+			return fmterr.Errorf("error applying OpenTelekomCloud WAF Dedicated policy rules: %w", err)
+		}
+	}
+
+	clientCtx := common.CtxWithClient(ctx, client, keyClientV1)
+	return resourceWafDedicatedPolicyRulesV1Read(clientCtx, d, meta)
+}
+
+func resourceWafDedicatedPolicyRulesV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := common.ClientFromCtx(ctx, keyClientV1, func() (*golangsdk.ServiceClient, error) {
+		return config.WafDedicatedV1Client(config.GetRegion(d))
+	})
+	if err != nil {
+		return fmterr.Errorf(errCreationV1DedicatedClient, err)
+	}
+
+	if err := reconcileWafPolicyRules(client, d.Id(), "{}"); err != nil {
+		return fmterr.Errorf("error deleting OpenTelekomCloud WAF Dedicated policy rules: %w", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// reconcileWafPolicyRules makes the rules of the policy match the document.
+// Rules are matched by their content: not matching rules are deleted, missing rules are created
+// and the status of the matching rules is changed if needed.
+func reconcileWafPolicyRules(client *golangsdk.ServiceClient, policyID, document string) error {
+	desired, err := parseWafRulesDocument(document)
+	if err != nil {
+		return err
+	}
+
+	for _, kindName := range wafRuleKindNames() {
+		kind := wafRuleKinds[kindName]
+		existing, err := listWafPolicyRules(client, policyID, kind)
+		if err != nil {
+			return fmt.Errorf("error listing %s rules: %w", kindName, err)
+		}
+
+		var toCreate []wafRule
+		matched := make([]bool, len(existing))
+		for _, rule := range desired[kindName] {
+			found := false
+			for i, current := range existing {
+				if matched[i] || current.Body != rule.Body {
+					continue
+				}
+				matched[i] = true
+				found = true
+				if kind.hasStatus && current.Enabled != rule.Enabled {
+					if err := changeWafRuleStatus(client, policyID, kind, current.ID, rule.Enabled); err != nil {
+						return fmt.Errorf("error changing status of %s rule %s: %w", kindName, current.ID, err)
+					}
+				}
+				break
+			}
+			if !found {
+				toCreate = append(toCreate, rule)
+			}
+		}
+
+		// delete first, so the rules with the same priority or URL can be recreated
+		for i, current := range existing {
+			if matched[i] {
+				continue
+			}
+			log.Printf("[DEBUG] Deleting WAF Dedicated %s rule %s", kindName, current.ID)
+			if err := kind.delete(client, policyID, current.ID); err != nil {
+				return fmt.Errorf("error deleting %s rule %s: %w", kindName, current.ID, err)
+			}
+		}
+
+		for _, rule := range toCreate {
+			opts := kind.opts()
+			if err := json.Unmarshal([]byte(rule.Body), opts); err != nil {
+				return err
+			}
+			id, err := kind.create(client, policyID, opts)
+			if err != nil {
+				return fmt.Errorf("error creating %s rule: %w", kindName, err)
+			}
+			log.Printf("[DEBUG] Created WAF Dedicated %s rule %s", kindName, id)
+			if !rule.Enabled {
+				if err := changeWafRuleStatus(client, policyID, kind, id, false); err != nil {
+					return fmt.Errorf("error disabling %s rule %s: %w", kindName, id, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func changeWafRuleStatus(client *golangsdk.ServiceClient, policyID string, kind wafRuleKind, ruleID string, enabled bool) error {
+	status := 0
+	if enabled {
+		status = 1
+	}
+	_, err := rules.ChangeRuleStatus(client, policyID, kind.path, ruleID, rules.ChangeStatusOpts{Status: status})
+	return err
+}
@@ -0,0 +1,147 @@
+package waf
+
+import (
+	"encoding/json"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestCanonicalWafRuleBody(t *testing.T) {
+	cases := []struct {
+		name     string
+		kind     string
+		document string
+		api      string
+		expected string
+	}{
+		{
+			name:     "cc rule without lock_time",
+			kind:     "cc",
+			document: `{"mode":0,"url":"/login","action":{"category":"captcha"},"tag_type":"ip","limit_num":10,"limit_period":60}`,
+			api: `{"id":"a1","policyid":"p1","timestamp":1650000000000,"status":1,"mode":0,"url":"/login",` +
+				`"action":{"category":"captcha","detail":null},"tag_type":"ip","tag_index":"","limit_num":10,` +
+				`"limit_period":60,"unlock_num":0,"lock_time":0,"description":""}`,
+			expected: `{"action":{"category":"captcha"},"limit_num":10,"limit_period":60,"mode":0,"tag_type":"ip","unlock_num":0,"url":"/login"}`,
+		},
+		{
+			name:     "cc rule with lock_time",
+			kind:     "cc",
+			document: `{"mode":0,"url":"/login","action":{"category":"block"},"tag_type":"ip","limit_num":10,"limit_period":60,"lock_time":30}`,
+			api: `{"id":"a1","policyid":"p1","mode":0,"url":"/login","action":{"category":"block"},"tag_type":"ip",` +
+				`"limit_num":10,"limit_period":60,"unlock_num":0,"lock_time":30}`,
+			expected: `{"action":{"category":"block"},"limit_num":10,"limit_period":60,"lock_time":30,"mode":0,"tag_type":"ip","unlock_num":0,"url":"/login"}`,
+		},
+		{
+			name:     "blacklist keeps zero action",
+			kind:     "blacklist",
+			document: `{"name":"block","addr":"192.168.1.0/24","white":0}`,
+			api:      `{"id":"b1","policyid":"p1","name":"block","addr":"192.168.1.0/24","white":0,"status":1,"description":""}`,
+			expected: `{"addr":"192.168.1.0/24","name":"block","white":0}`,
+		},
+		{
+			name: "precise protection keeps zero priority",
+			kind: "precise_protection",
+			document: `{"time":false,"priority":0,"action":{"category":"block"},` +
+				`"conditions":[{"category":"url","logic_operation":"contain","contents":["/admin"]}]}`,
+			api: `{"id":"c1","policyid":"p1","time":false,"start":0,"terminal":0,"priority":0,` +
+				`"action":{"category":"block"},"conditions":[{"category":"url","logic_operation":"contain",` +
+				`"contents":["/admin"],"index":""}],"status":1}`,
+			expected: `{"action":{"category":"block"},"conditions":[{"category":"url","contents":["/admin"],"logic_operation":"contain"}],` +
+				`"priority":0,"time":false}`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			kind := wafRuleKinds[c.kind]
+
+			var document map[string]interface{}
+			th.AssertNoErr(t, json.Unmarshal([]byte(c.document), &document))
+			fromDocument, err := canonicalWafRuleBody(kind, document)
+			th.AssertNoErr(t, err)
+
+			fromAPI, err := canonicalWafRuleBody(kind, json.RawMessage(c.api))
+			th.AssertNoErr(t, err)
+
+			th.AssertEquals(t, c.expected, fromDocument)
+			th.AssertEquals(t, fromDocument, fromAPI)
+		})
+	}
+}
+
+func TestParseWafRulesDocument(t *testing.T) {
+	document := `{
+  "blacklist": [
+    {"name": "allow", "addr": "10.0.0.1", "white": 1, "enabled": false},
+    {"name": "block", "addr": "192.168.1.0/24", "white": 0, "description": ""}
+  ],
+  "known_attack_source": [
+    {"category": "long_ip_block", "block_time": 300}
+  ],
+  "geo_ip": []
+}`
+
+	ruleSet, err := parseWafRulesDocument(document)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string][]wafRule{
+		"blacklist": {
+			{Enabled: false, Body: `{"addr":"10.0.0.1","name":"allow","white":1}`},
+			{Enabled: true, Body: `{"addr":"192.168.1.0/24","name":"block","white":0}`},
+		},
+		"known_attack_source": {
+			{Enabled: true, Body: `{"block_time":300,"category":"long_ip_block"}`},
+		},
+	}, ruleSet)
+}
+
+func TestParseWafRulesDocumentErrors(t *testing.T) {
+	cases := map[string]string{
+		"not an object":             `[]`,
+		"invalid JSON":              `{"blacklist": [`,
+		"unsupported rule type":     `{"whitelist": [{"addr": "10.0.0.1"}]}`,
+		"non-boolean enabled":       `{"blacklist": [{"addr": "10.0.0.1", "white": 0, "enabled": "no"}]}`,
+		"disabled rule w/o status":  `{"known_attack_source": [{"category": "long_ip_block", "block_time": 300, "enabled": false}]}`,
+		"invalid rule field format": `{"blacklist": [{"addr": "10.0.0.1", "white": "block"}]}`,
+	}
+
+	for name, document := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseWafRulesDocument(document); err == nil {
+				t.Fatalf("expected error for %s", document)
+			}
+		})
+	}
+}
+
+func TestRenderWafRulesDocument(t *testing.T) {
+	ruleSet := map[string][]wafRule{
+		"blacklist": {
+			{ID: "b2", Enabled: true, Body: `{"addr":"192.168.1.0/24","name":"block","white":0}`},
+			{ID: "b1", Enabled: false, Body: `{"addr":"10.0.0.1","name":"allow","white":1}`},
+		},
+		"geo_ip": nil,
+	}
+
+	document, err := renderWafRulesDocument(ruleSet)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, `{"blacklist":[{"addr":"10.0.0.1","enabled":false,"name":"allow","white":1},`+
+		`{"addr":"192.168.1.0/24","name":"block","white":0}]}`, document)
+
+	// rendered document is parsed back to the same rules
+	parsed, err := parseWafRulesDocument(document)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(parsed["blacklist"]))
+	for _, rule := range ruleSet["blacklist"] {
+		found := false
+		for _, other := range parsed["blacklist"] {
+			if other.Body == rule.Body && other.Enabled == rule.Enabled {
+				found = true
+			}
+		}
+		th.AssertEquals(t, true, found)
+	}
+
+	// normalization is stable
+	th.AssertEquals(t, document, normalizeWafRulesDocument(document))
+}
@@ -244,30 +244,7 @@ func resourceWafDedicatedPolicyV1Read(ctx context.Context, d *schema.ResourceDat
 		log.Printf("[WARN] error flatten extend map: %s", err)
 	}
 
-	options := []map[string]interface{}{
-		{
-			"web_attack":                 policy.Options.WebAttack,
-			"common":                     policy.Options.Common,
-			"crawler":                    policy.Options.Crawler,
-			"anti_crawler":               policy.Options.AntiCrawler,
-			"crawler_engine":             policy.Options.CrawlerEngine,
-			"crawler_scanner":            policy.Options.CrawlerScanner,
-			"crawler_script":             policy.Options.CrawlerScript,
-			"crawler_other":              policy.Options.CrawlerOther,
-			"web_shell":                  policy.Options.WebShell,
-			"cc":                         policy.Options.Cc,
-			"custom":                     policy.Options.Custom,
-			"blacklist":                  policy.Options.WhiteblackIp,
-			"geolocation_access_control": policy.Options.GeoIp,
-			"ignore":                     policy.Options.Ignore,
-			"privacy":                    policy.Options.Privacy,
-			"anti_tamper":                policy.Options.AntiTamper,
-			"anti_leakage":               policy.Options.AntiLeakage,
-			"followed_action":            policy.Options.FollowedAction,
-			"bot_enable":                 policy.Options.BotEnable,
-			"precise":                    policy.Options.Precise,
-		},
-	}
+	options := flattenWafDedicatedPolicyOptions(policy.Options)
 
 	mErr := multierror.Append(nil,
 		d.Set("region", config.GetRegion(d)),
@@ -372,6 +349,36 @@ func updateWafPolicy(ctx context.Context, d *schema.ResourceData, meta interface
 	return nil
 }
 
+func flattenWafDedicatedPolicyOptions(options *policies.PolicyOption) []map[string]interface{} {
+	if options == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"web_attack":                 options.WebAttack,
+			"common":                     options.Common,
+			"crawler":                    options.Crawler,
+			"anti_crawler":               options.AntiCrawler,
+			"crawler_engine":             options.CrawlerEngine,
+			"crawler_scanner":            options.CrawlerScanner,
+			"crawler_script":             options.CrawlerScript,
+			"crawler_other":              options.CrawlerOther,
+			"web_shell":                  options.WebShell,
+			"cc":                         options.Cc,
+			"custom":                     options.Custom,
+			"blacklist":                  options.WhiteblackIp,
+			"geolocation_access_control": options.GeoIp,
+			"ignore":                     options.Ignore,
+			"privacy":                    options.Privacy,
+			"anti_tamper":                options.AntiTamper,
+			"anti_leakage":               options.AntiLeakage,
+			"followed_action":            options.FollowedAction,
+			"bot_enable":                 options.BotEnable,
+			"precise":                    options.Precise,
+		},
+	}
+}
+
 func buildOptions(d *schema.ResourceData) *policies.PolicyOption {
 	optionsRaw := d.Get("options").([]interface{})
 	rawMap := optionsRaw[0].(map[string]interface{})